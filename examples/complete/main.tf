@@ -117,6 +117,66 @@ resource "netdata_notification_pagerduty_channel" "test" {
   integration_key  = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
 }
 
+resource "netdata_notification_opsgenie_channel" "test" {
+  name = "opsgenie"
+
+  enabled       = true
+  space_id      = netdata_space.test.id
+  notifications = ["CRITICAL", "WARNING", "CLEAR"]
+  api_key       = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "netdata_notification_msteams_channel" "test" {
+  name = "msteams"
+
+  enabled       = true
+  space_id      = netdata_space.test.id
+  notifications = ["CRITICAL", "WARNING", "CLEAR"]
+  webhook_url   = "https://example.webhook.office.com/webhookb2/00000000-0000-0000-0000-000000000000/IncomingWebhook/XXXXXXXXXXXXXXXXXXXXXXXX"
+}
+
+resource "netdata_notification_telegram_channel" "test" {
+  name = "telegram"
+
+  enabled       = true
+  space_id      = netdata_space.test.id
+  notifications = ["CRITICAL", "WARNING", "CLEAR"]
+  bot_token     = "000000000:XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+  chat_id       = "-1000000000000"
+}
+
+resource "netdata_notification_email_channel" "test" {
+  name = "email"
+
+  enabled       = true
+  space_id      = netdata_space.test.id
+  notifications = ["CRITICAL", "WARNING"]
+}
+
+resource "netdata_notification_webhook_channel" "test" {
+  name = "webhook"
+
+  enabled       = true
+  space_id      = netdata_space.test.id
+  notifications = ["CRITICAL", "WARNING", "CLEAR"]
+  webhook_url   = "https://example.com/netdata/alerts"
+  auth_method   = "basic"
+  username      = "netdata"
+  password      = "XXXXXXXXXXXXXXXX"
+}
+
+resource "netdata_notification_channel" "test" {
+  name = "mattermost"
+
+  enabled       = true
+  space_id      = netdata_space.test.id
+  notifications = ["CRITICAL", "WARNING", "CLEAR"]
+  integration   = "mattermost"
+  secrets = jsonencode({
+    url = "https://mattermost.example.com/hooks/XXXXXXXXXXXXXXXXXXXXXXXXXX"
+  })
+}
+
 data "netdata_space" "test" {
   id = netdata_space.test.id
 }
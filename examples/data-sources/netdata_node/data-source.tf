@@ -0,0 +1,4 @@
+data "netdata_node" "test" {
+  space_id = "<space_id>"
+  name     = "<hostname>"
+}
@@ -0,0 +1,12 @@
+data "netdata_nodes" "production_parents" {
+  space_id   = "<space_id>"
+  name_regex = "^prod-"
+  state      = "reachable"
+  labels = {
+    role = "parent"
+  }
+}
+
+output "production_parent_ids" {
+  value = data.netdata_nodes.production_parents.nodes[*].id
+}
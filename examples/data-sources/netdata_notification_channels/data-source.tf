@@ -0,0 +1,4 @@
+data "netdata_notification_channels" "test" {
+  space_id    = "<space_id>"
+  integration = "slack"
+}
@@ -0,0 +1,14 @@
+data "netdata_notification_integrations" "test" {
+  space_id = "<space_id>"
+}
+
+locals {
+  available_integrations = [for integration in data.netdata_notification_integrations.test.integrations : integration.slug if integration.available]
+}
+
+check "pagerduty_available" {
+  assert {
+    condition     = contains(local.available_integrations, "pagerduty")
+    error_message = "The plan of the space doesn't include the PagerDuty integration"
+  }
+}
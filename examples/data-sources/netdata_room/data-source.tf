@@ -2,3 +2,8 @@ data "netdata_room" "test" {
   space_id = "<space_id>"
   id       = "<room_id>"
 }
+
+data "netdata_room" "by_name" {
+  space_id = "<space_id>"
+  name     = "<room_name>"
+}
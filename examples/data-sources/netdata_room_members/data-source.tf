@@ -0,0 +1,8 @@
+data "netdata_room_members" "test" {
+  space_id = "<space_id>"
+  room_id  = "<room_id>"
+}
+
+output "room_member_emails" {
+  value = data.netdata_room_members.test.members[*].email
+}
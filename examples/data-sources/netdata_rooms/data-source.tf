@@ -0,0 +1,8 @@
+data "netdata_rooms" "all_nodes" {
+  space_id   = "<space_id>"
+  name_regex = "^All nodes$"
+}
+
+output "all_nodes_room_id" {
+  value = data.netdata_rooms.all_nodes.rooms[0].id
+}
@@ -1,3 +1,7 @@
 data "netdata_space" "test" {
   id = "<space_id>"
 }
+
+data "netdata_space" "by_name" {
+  name = "<space_name>"
+}
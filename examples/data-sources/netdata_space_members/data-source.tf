@@ -0,0 +1,11 @@
+data "netdata_space_members" "admins" {
+  space_id = "<space_id>"
+  role     = "admin"
+}
+
+check "admins_count" {
+  assert {
+    condition     = length(data.netdata_space_members.admins.members) <= 3
+    error_message = "The space has more than 3 admins"
+  }
+}
@@ -0,0 +1,10 @@
+data "netdata_spaces" "production" {
+  name_regex = "^prod-"
+}
+
+resource "netdata_room" "databases" {
+  for_each = { for space in data.netdata_spaces.production.spaces : space.id => space }
+
+  space_id = each.key
+  name     = "Databases"
+}
@@ -0,0 +1,13 @@
+resource "netdata_notification_channel" "test" {
+  name = "mattermost notifications"
+
+  enabled                 = true
+  space_id                = "<space_id>"
+  rooms_id                = ["<room_id>"]
+  repeat_notification_min = 30
+  notifications           = ["CRITICAL", "WARNING", "CLEAR"]
+  integration             = "mattermost"
+  secrets = jsonencode({
+    url = "https://mattermost.example.com/hooks/XXXXXXXXXXXXXXXXXXXXXXXXXX"
+  })
+}
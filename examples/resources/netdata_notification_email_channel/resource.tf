@@ -0,0 +1,9 @@
+resource "netdata_notification_email_channel" "test" {
+  name = "email notifications"
+
+  enabled                 = true
+  space_id                = "<space_id>"
+  rooms_id                = ["<room_id>"]
+  repeat_notification_min = 30
+  notifications           = ["CRITICAL", "WARNING", "CLEAR"]
+}
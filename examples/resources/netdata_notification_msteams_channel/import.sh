@@ -0,0 +1,3 @@
+#!/bin/sh
+
+terraform import netdata_notification_msteams_channel.test space_id,channel_id
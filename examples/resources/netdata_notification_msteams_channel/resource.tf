@@ -0,0 +1,10 @@
+resource "netdata_notification_msteams_channel" "test" {
+  name = "msteams notifications"
+
+  enabled                 = true
+  space_id                = "<space_id>"
+  rooms_id                = ["<room_id>"]
+  repeat_notification_min = 30
+  webhook_url             = "https://example.webhook.office.com/webhookb2/00000000-0000-0000-0000-000000000000/IncomingWebhook/XXXXXXXXXXXXXXXXXXXXXXXX"
+  notifications           = ["CRITICAL", "WARNING", "CLEAR"]
+}
@@ -0,0 +1,11 @@
+resource "netdata_notification_opsgenie_channel" "test" {
+  name = "opsgenie notifications"
+
+  enabled                 = true
+  space_id                = netdata_space.test.id
+  rooms_id                = ["<room_id>"]
+  notifications           = ["CRITICAL", "WARNING", "CLEAR"]
+  repeat_notification_min = 30
+  api_key                 = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+  api_url                 = "https://api.eu.opsgenie.com"
+}
@@ -0,0 +1,3 @@
+#!/bin/sh
+
+terraform import netdata_notification_telegram_channel.test space_id,channel_id
@@ -0,0 +1,11 @@
+resource "netdata_notification_telegram_channel" "test" {
+  name = "telegram notifications"
+
+  enabled                 = true
+  space_id                = "<space_id>"
+  rooms_id                = ["<room_id>"]
+  repeat_notification_min = 30
+  bot_token               = "000000000:XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+  chat_id                 = "-1000000000000"
+  notifications           = ["CRITICAL", "WARNING", "CLEAR"]
+}
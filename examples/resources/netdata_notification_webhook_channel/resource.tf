@@ -0,0 +1,15 @@
+resource "netdata_notification_webhook_channel" "test" {
+  name = "webhook notifications"
+
+  enabled                 = true
+  space_id                = "<space_id>"
+  rooms_id                = ["<room_id>"]
+  repeat_notification_min = 30
+  webhook_url             = "https://example.com/netdata/alerts"
+  auth_method             = "bearer"
+  bearer_token            = "<bearer_token>"
+  http_headers = {
+    "X-Team" = "sre"
+  }
+  notifications = ["CRITICAL", "WARNING", "CLEAR"]
+}
@@ -0,0 +1,3 @@
+#!/bin/sh
+
+terraform import netdata_room_members.test space_id,room_id
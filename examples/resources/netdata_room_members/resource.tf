@@ -0,0 +1,8 @@
+resource "netdata_room_members" "test" {
+  room_id  = "<room_id>"
+  space_id = "<space_id>"
+  space_member_ids = [
+    "<space_member_id>",
+    "<another_space_member_id>",
+  ]
+}
@@ -0,0 +1,3 @@
+#!/bin/sh
+
+terraform import netdata_space_invitation.test space_id,id
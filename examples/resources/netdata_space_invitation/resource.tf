@@ -0,0 +1,6 @@
+resource "netdata_space_invitation" "test" {
+  email    = "<email>"
+  space_id = "<space_id>"
+  role     = "admin"
+  rooms_id = ["<room_id>"]
+}
@@ -0,0 +1,3 @@
+#!/bin/sh
+
+terraform import netdata_space_members.test space_id
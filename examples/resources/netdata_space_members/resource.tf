@@ -0,0 +1,7 @@
+resource "netdata_space_members" "test" {
+  space_id = "<space_id>"
+  members = {
+    "owner@example.com"  = "admin"
+    "member@example.com" = "member"
+  }
+}
@@ -0,0 +1,24 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// GetCurrentAccount returns the account the authentication token belongs to.
+func (c *Client) GetCurrentAccount(ctx context.Context) (*Account, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/accounts/me", c.HostURL), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var account Account
+
+	err = c.doRequestUnmarshal(req, &account)
+	if err != nil {
+		return nil, err
+	}
+
+	return &account, nil
+}
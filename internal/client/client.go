@@ -3,10 +3,12 @@ package client
 import (
 	"encoding/json"
 	"errors"
-	"fmt"
 	"io"
+	"math"
 	"net/http"
 	"time"
+
+	"golang.org/x/time/rate"
 )
 
 var (
@@ -20,17 +22,36 @@ var (
 	ErrNodeMembershipActionRequired = errors.New("nodeMembershipAction is required")
 )
 
+const (
+	DefaultHTTPTimeout = 10 * time.Second
+
+	DefaultMaxRetries   = 3
+	DefaultRetryMaxWait = 30 * time.Second
+
+	DefaultRequestsPerSecond = 10
+	DefaultBurst             = 10
+)
+
 type Client struct {
 	HostURL    string
 	HTTPClient *http.Client
 	AuthToken  string
+	// MaxRetries is the number of times a failed request is retried, 0 disables retries.
+	MaxRetries int
+	// RetryMaxWait caps the time to wait between two attempts.
+	RetryMaxWait time.Duration
+	// RateLimiter throttles every attempt, including retries, it is shared by all the resources.
+	RateLimiter *rate.Limiter
 }
 
 func NewClient(url, auth_token string) *Client {
 	c := Client{
-		HostURL:    url,
-		AuthToken:  "Bearer " + auth_token,
-		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+		HostURL:      url,
+		AuthToken:    "Bearer " + auth_token,
+		HTTPClient:   &http.Client{Timeout: DefaultHTTPTimeout},
+		MaxRetries:   DefaultMaxRetries,
+		RetryMaxWait: DefaultRetryMaxWait,
+		RateLimiter:  rate.NewLimiter(DefaultRequestsPerSecond, DefaultBurst),
 	}
 
 	return &c
@@ -40,23 +61,66 @@ func (c *Client) doRequest(req *http.Request) ([]byte, error) {
 	req.Header.Set("Authorization", c.AuthToken)
 	req.Header.Set("Accept", "application/json")
 
+	var lastErr error
+	for attempt := 0; ; attempt++ {
+		if c.RateLimiter != nil {
+			if err := c.RateLimiter.Wait(req.Context()); err != nil {
+				return nil, withLastError(err, lastErr)
+			}
+		}
+
+		res, body, err := c.doAttempt(req)
+		if attempt >= c.MaxRetries || !shouldRetry(req, res, err) {
+			if err != nil {
+				return nil, err
+			}
+			statusOK := res.StatusCode >= 200 && res.StatusCode < 300
+			if !statusOK {
+				return nil, newAPIError(req, res, body)
+			}
+			return body, nil
+		}
+
+		lastErr = err
+		if lastErr == nil {
+			lastErr = newAPIError(req, res, body)
+		}
+		if err := sleepContext(req.Context(), c.retryWait(attempt, res)); err != nil {
+			return nil, withLastError(err, lastErr)
+		}
+
+		if req.Body != nil && req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+}
+
+// SetRateLimit configures the token bucket limiting the requests sent to Netdata Cloud,
+// a non-positive or non-finite requestsPerSecond disables the limit.
+func (c *Client) SetRateLimit(requestsPerSecond float64, burst int) {
+	if requestsPerSecond <= 0 || math.IsNaN(requestsPerSecond) || math.IsInf(requestsPerSecond, 0) {
+		c.RateLimiter = rate.NewLimiter(rate.Inf, 0)
+		return
+	}
+	c.RateLimiter = rate.NewLimiter(rate.Limit(requestsPerSecond), max(burst, 1))
+}
+
+func (c *Client) doAttempt(req *http.Request) (*http.Response, []byte, error) {
 	res, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	defer res.Body.Close()
 
 	body, err := io.ReadAll(res.Body)
 	if err != nil {
-		return nil, err
-	}
-
-	statusOK := res.StatusCode >= 200 && res.StatusCode < 300
-	if !statusOK {
-		return nil, fmt.Errorf("uri: %s, method: %s, status: %d, body: %s", req.URL.RequestURI(), req.Method, res.StatusCode, body)
+		return nil, nil, err
 	}
 
-	return body, err
+	return res, body, nil
 }
 
 func (c *Client) doRequestUnmarshal(req *http.Request, out any) error {
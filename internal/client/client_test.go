@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+func TestSetRateLimit(t *testing.T) {
+	tests := []struct {
+		name              string
+		requestsPerSecond float64
+		burst             int
+		wantLimit         rate.Limit
+		wantBurst         int
+	}{
+		{name: "limited", requestsPerSecond: 5, burst: 3, wantLimit: 5, wantBurst: 3},
+		{name: "burst at least one", requestsPerSecond: 5, burst: 0, wantLimit: 5, wantBurst: 1},
+		{name: "zero disables", requestsPerSecond: 0, burst: 3, wantLimit: rate.Inf},
+		{name: "negative disables", requestsPerSecond: -1, burst: 3, wantLimit: rate.Inf},
+		{name: "NaN disables", requestsPerSecond: math.NaN(), burst: 3, wantLimit: rate.Inf},
+		{name: "Inf disables", requestsPerSecond: math.Inf(1), burst: 3, wantLimit: rate.Inf},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewClient("http://netdata.local", "token")
+			c.SetRateLimit(tt.requestsPerSecond, tt.burst)
+			if got := c.RateLimiter.Limit(); got != tt.wantLimit {
+				t.Errorf("expected limit %v, got %v", tt.wantLimit, got)
+			}
+			if tt.wantLimit != rate.Inf && c.RateLimiter.Burst() != tt.wantBurst {
+				t.Errorf("expected burst %d, got %d", tt.wantBurst, c.RateLimiter.Burst())
+			}
+		})
+	}
+}
+
+func TestDoRequestRateLimited(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	c := NewClient(server.URL, "token")
+	c.SetRateLimit(20, 1)
+
+	start := time.Now()
+	for range 3 {
+		req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL, nil)
+		if _, err := c.doRequest(req); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	// the first request uses the burst, the next two wait 50ms each
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("requests not rate limited, took %v", elapsed)
+	}
+}
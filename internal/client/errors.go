@@ -0,0 +1,66 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// APIError is returned when Netdata Cloud responds with a non-2xx status code.
+type APIError struct {
+	StatusCode int
+	Method     string
+	URI        string
+	// RequestID identifies the request in Netdata Cloud, useful when reporting issues.
+	RequestID string
+	// ErrorCode, ErrorMsgKey and Message are decoded from the error body, when present.
+	ErrorCode   string
+	ErrorMsgKey string
+	Message     string
+	Body        []byte
+}
+
+type apiErrorBody struct {
+	ErrorCode    string `json:"errorCode"`
+	ErrorMsgKey  string `json:"errorMsgKey"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+func newAPIError(req *http.Request, res *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: res.StatusCode,
+		Method:     req.Method,
+		URI:        req.URL.RequestURI(),
+		RequestID:  res.Header.Get("X-Request-Id"),
+		Body:       body,
+	}
+
+	var errBody apiErrorBody
+	if json.Unmarshal(body, &errBody) == nil {
+		apiErr.ErrorCode = errBody.ErrorCode
+		apiErr.ErrorMsgKey = errBody.ErrorMsgKey
+		apiErr.Message = errBody.ErrorMessage
+	}
+
+	return apiErr
+}
+
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("uri: %s, method: %s, status: %d, body: %s", e.URI, e.Method, e.StatusCode, e.Body)
+	if e.RequestID != "" {
+		msg += ", request_id: " + e.RequestID
+	}
+	return msg
+}
+
+// Is allows errors.Is(err, ErrNotFound) to match the 404 responses.
+func (e *APIError) Is(target error) bool {
+	return target == ErrNotFound && e.StatusCode == http.StatusNotFound
+}
+
+// IsStatus reports whether err is an APIError with the given status code.
+func IsStatus(err error, statusCode int) bool {
+	var apiErr *APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == statusCode
+}
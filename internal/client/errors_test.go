@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIError(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		body          string
+		requestID     string
+		wantCode      string
+		wantMsgKey    string
+		wantMessage   string
+		wantNotFound  bool
+		wantErrString string
+	}{
+		{
+			name:          "json body",
+			status:        http.StatusBadRequest,
+			body:          `{"errorCode":"ErrInvalidRequest","errorMsgKey":"ErrInvalidRequest","errorMessage":"invalid role"}`,
+			requestID:     "abc-123",
+			wantCode:      "ErrInvalidRequest",
+			wantMsgKey:    "ErrInvalidRequest",
+			wantMessage:   "invalid role",
+			wantErrString: `uri: /api/v2/spaces, method: GET, status: 400, body: {"errorCode":"ErrInvalidRequest","errorMsgKey":"ErrInvalidRequest","errorMessage":"invalid role"}, request_id: abc-123`,
+		},
+		{
+			name:          "non-json body",
+			status:        http.StatusBadGateway,
+			body:          "<html>bad gateway</html>",
+			wantErrString: "uri: /api/v2/spaces, method: GET, status: 502, body: <html>bad gateway</html>",
+		},
+		{
+			name:          "not found",
+			status:        http.StatusNotFound,
+			body:          `{"errorCode":"ErrNotFound","errorMessage":"space not found"}`,
+			requestID:     "def-456",
+			wantCode:      "ErrNotFound",
+			wantMessage:   "space not found",
+			wantNotFound:  true,
+			wantErrString: `uri: /api/v2/spaces, method: GET, status: 404, body: {"errorCode":"ErrNotFound","errorMessage":"space not found"}, request_id: def-456`,
+		},
+		{
+			name:          "not found without body",
+			status:        http.StatusNotFound,
+			wantNotFound:  true,
+			wantErrString: "uri: /api/v2/spaces, method: GET, status: 404, body: ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if tt.requestID != "" {
+					w.Header().Set("X-Request-Id", tt.requestID)
+				}
+				w.WriteHeader(tt.status)
+				fmt.Fprint(w, tt.body)
+			}))
+			defer server.Close()
+
+			c := newTestClient(server.URL)
+			c.MaxRetries = 0
+			req, _ := http.NewRequestWithContext(context.Background(), http.MethodGet, server.URL+"/api/v2/spaces", nil)
+			_, err := c.doRequest(req)
+
+			var apiErr *APIError
+			if !errors.As(err, &apiErr) {
+				t.Fatalf("expected an APIError, got: %v", err)
+			}
+			if apiErr.StatusCode != tt.status {
+				t.Errorf("expected status %d, got %d", tt.status, apiErr.StatusCode)
+			}
+			if apiErr.RequestID != tt.requestID {
+				t.Errorf("expected request ID %q, got %q", tt.requestID, apiErr.RequestID)
+			}
+			if apiErr.ErrorCode != tt.wantCode || apiErr.ErrorMsgKey != tt.wantMsgKey || apiErr.Message != tt.wantMessage {
+				t.Errorf("unexpected decoded body: %q, %q, %q", apiErr.ErrorCode, apiErr.ErrorMsgKey, apiErr.Message)
+			}
+			if got := errors.Is(err, ErrNotFound); got != tt.wantNotFound {
+				t.Errorf("errors.Is(err, ErrNotFound) = %v, want %v", got, tt.wantNotFound)
+			}
+			if !IsStatus(err, tt.status) {
+				t.Errorf("IsStatus(err, %d) = false", tt.status)
+			}
+			if err.Error() != tt.wantErrString {
+				t.Errorf("unexpected error string:\n got: %s\nwant: %s", err.Error(), tt.wantErrString)
+			}
+		})
+	}
+}
+
+func TestIsStatus(t *testing.T) {
+	wrapped := fmt.Errorf("reading space: %w", &APIError{StatusCode: http.StatusForbidden})
+
+	if !IsStatus(wrapped, http.StatusForbidden) {
+		t.Error("expected a wrapped APIError to match its status")
+	}
+	if IsStatus(wrapped, http.StatusNotFound) {
+		t.Error("expected a different status not to match")
+	}
+	if IsStatus(errors.New("connection reset"), http.StatusForbidden) {
+		t.Error("expected a non APIError not to match")
+	}
+	if errors.Is(wrapped, ErrNotFound) {
+		t.Error("expected a 403 not to match ErrNotFound")
+	}
+}
@@ -1,16 +1,19 @@
 package client
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 )
 
-func (c *Client) GetInvitations(spaceID string) (*[]Invitation, error) {
+func (c *Client) GetInvitations(ctx context.Context, spaceID string) (*[]Invitation, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/invitations", c.HostURL, spaceID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/invitations", c.HostURL, spaceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -25,7 +28,88 @@ func (c *Client) GetInvitations(spaceID string) (*[]Invitation, error) {
 	return &invitations, nil
 }
 
-func (c *Client) DeleteInvitations(spaceID string, invitations *[]Invitation) error {
+func (c *Client) GetInvitationByEmail(ctx context.Context, spaceID, email string) (*Invitation, error) {
+	invitations, err := c.GetInvitations(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range *invitations {
+		if strings.EqualFold(invitation.Email, email) {
+			return &invitation, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (c *Client) GetInvitationByID(ctx context.Context, spaceID, invitationID string) (*Invitation, error) {
+	invitations, err := c.GetInvitations(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range *invitations {
+		if invitation.ID == invitationID {
+			return &invitation, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (c *Client) CreateInvitation(ctx context.Context, spaceID, email, role string, roomIDs []string) (*Invitation, error) {
+	if spaceID == "" {
+		return nil, ErrSpaceIDRequired
+	}
+	if email == "" {
+		return nil, fmt.Errorf("email is empty")
+	}
+	if role == "" {
+		return nil, fmt.Errorf("role is empty")
+	}
+	if roomIDs == nil {
+		roomIDs = []string{}
+	}
+	reqBody, err := json.Marshal(invitationRequestPayload{
+		Email:   []string{email},
+		Role:    role,
+		RoomIDs: roomIDs,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the response doesn't include the invitation, so the new one is the invitation
+	// for the email with an ID that didn't exist before sending it
+	existing, err := c.GetInvitations(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	existingIDs := make(map[string]bool, len(*existing))
+	for _, invitation := range *existing {
+		existingIDs[invitation.ID] = true
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v1/spaces/%s/invitations", c.HostURL, spaceID), bytes.NewReader(reqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = c.doRequest(req)
+	if err != nil {
+		return nil, err
+	}
+
+	invitations, err := c.GetInvitations(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, invitation := range *invitations {
+		if !existingIDs[invitation.ID] && strings.EqualFold(invitation.Email, email) {
+			return &invitation, nil
+		}
+	}
+	return nil, fmt.Errorf("invitation for %s not found after sending it: %w", email, ErrNotFound)
+}
+
+func (c *Client) DeleteInvitations(ctx context.Context, spaceID string, invitations *[]Invitation) error {
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
@@ -38,7 +122,7 @@ func (c *Client) DeleteInvitations(spaceID string, invitations *[]Invitation) er
 	for _, invitation := range *invitations {
 		invitationIDs = append(invitationIDs, invitation.ID)
 	}
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v1/spaces/%s/invitations?invitation_ids=%s", c.HostURL, spaceID, strings.Join(invitationIDs, ",")), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v1/spaces/%s/invitations?invitation_ids=%s", c.HostURL, spaceID, strings.Join(invitationIDs, ",")), nil)
 	if err != nil {
 		return err
 	}
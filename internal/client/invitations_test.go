@@ -0,0 +1,67 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestCreateInvitation(t *testing.T) {
+	tests := []struct {
+		name    string
+		after   string
+		wantID  string
+		wantErr bool
+	}{
+		{
+			name:   "new invitation",
+			after:  `[{"id":"old","email":"user@example.com"},{"id":"new","email":"User@example.com"}]`,
+			wantID: "new",
+		},
+		{
+			name:    "only the older invitation",
+			after:   `[{"id":"old","email":"user@example.com"}]`,
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			sent := false
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch {
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/spaces/space/invitations":
+					sent = true
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v2/spaces/space/invitations":
+					if sent {
+						fmt.Fprint(w, tt.after)
+					} else {
+						fmt.Fprint(w, `[{"id":"old","email":"user@example.com"}]`)
+					}
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			defer server.Close()
+
+			c := newTestClient(server.URL)
+			invitation, err := c.CreateInvitation(context.Background(), "space", "user@example.com", "admin", nil)
+
+			if tt.wantErr {
+				if !errors.Is(err, ErrNotFound) {
+					t.Fatalf("expected ErrNotFound, got: %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if invitation.ID != tt.wantID {
+				t.Errorf("expected invitation %q, got %q", tt.wantID, invitation.ID)
+			}
+		})
+	}
+}
@@ -16,6 +16,14 @@ type RoomInfo struct {
 	ID          string `json:"id"`
 	Name        string `json:"name"`
 	Description string `json:"description"`
+	NodeCount   int64  `json:"node_count"`
+	MemberCount int64  `json:"member_count"`
+}
+
+type Account struct {
+	ID    string `json:"id"`
+	Name  string `json:"name"`
+	Email string `json:"email"`
 }
 
 type SpaceMember struct {
@@ -44,8 +52,9 @@ type NotificationChannel struct {
 }
 
 type NotificationIntegration struct {
-	ID   string `json:"id"`
-	Name string `json:"slug"`
+	ID        string `json:"id"`
+	Name      string `json:"slug"`
+	Available bool   `json:"available"`
 }
 
 type NotificationSlackChannel struct {
@@ -65,6 +74,29 @@ type NotificationPagerdutyChannel struct {
 	IntegrationKey string `json:"integrationKey"`
 }
 
+type NotificationMSTeamsChannel struct {
+	URL string `json:"url"`
+}
+
+type NotificationOpsgenieChannel struct {
+	APIKey string `json:"apiKey"`
+	URL    string `json:"url"`
+}
+
+type NotificationTelegramChannel struct {
+	BotToken string `json:"botToken"`
+	ChatID   string `json:"chatID"`
+}
+
+type NotificationWebhookChannel struct {
+	URL         string            `json:"url"`
+	HTTPHeaders map[string]string `json:"httpHeaders,omitempty"`
+	AuthMethod  string            `json:"authMethod"`
+	Username    string            `json:"username,omitempty"`
+	Password    string            `json:"password,omitempty"`
+	BearerToken string            `json:"bearerToken,omitempty"`
+}
+
 type notificationRequestPayload struct {
 	Name                     string          `json:"name"`
 	IntegrationID            string          `json:"integrationID"`
@@ -75,8 +107,18 @@ type notificationRequestPayload struct {
 }
 
 type Invitation struct {
-	ID    string `json:"id"`
-	Email string `json:"email"`
+	ID        string   `json:"id"`
+	Email     string   `json:"email"`
+	Role      string   `json:"role"`
+	RoomIDs   []string `json:"roomIDs"`
+	Status    string   `json:"status"`
+	ExpiresAt string   `json:"expiresAt"`
+}
+
+type invitationRequestPayload struct {
+	Email   []string `json:"email"`
+	Role    string   `json:"role"`
+	RoomIDs []string `json:"roomIDs"`
 }
 
 type RoomNodes struct {
@@ -84,10 +126,20 @@ type RoomNodes struct {
 }
 
 type RoomNode struct {
-	NodeID   string `json:"nd"`
-	NodeName string `json:"nm"`
-	State    string `json:"state"`
+	NodeID   string            `json:"nd"`
+	NodeName string            `json:"nm"`
+	State    string            `json:"state"`
+	Version  string            `json:"v"`
+	OS       RoomNodeOS        `json:"os"`
+	Labels   map[string]string `json:"labels"`
 }
+
+type RoomNodeOS struct {
+	ID      string `json:"id"`
+	Name    string `json:"nm"`
+	Version string `json:"v"`
+}
+
 type NodeMembershipRule struct {
 	ID          uuid.UUID              `json:"id"`
 	SpaceID     uuid.UUID              `json:"spaceID"`
@@ -2,12 +2,13 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) GetRoomNodes(spaceID, roomID string) (*RoomNodes, error) {
+func (c *Client) GetRoomNodes(ctx context.Context, spaceID, roomID string) (*RoomNodes, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
@@ -17,10 +18,12 @@ func (c *Client) GetRoomNodes(spaceID, roomID string) (*RoomNodes, error) {
 
 	reqBody := []byte(`{"scope":{"nodes":[]}}`)
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/nodes", c.HostURL, spaceID, roomID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/nodes", c.HostURL, spaceID, roomID), bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
+	// the nodes listing is a query sent as POST, so it is safe to retry
+	req = withIdempotent(req)
 
 	var roomNodes RoomNodes
 
@@ -32,12 +35,12 @@ func (c *Client) GetRoomNodes(spaceID, roomID string) (*RoomNodes, error) {
 	return &roomNodes, nil
 }
 
-func (c *Client) GetAllNodes(spaceID string) (*RoomNodes, error) {
+func (c *Client) GetAllNodes(ctx context.Context, spaceID string) (*RoomNodes, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
 
-	allRooms, err := c.GetRooms(spaceID)
+	allRooms, err := c.GetRooms(ctx, spaceID)
 	if err != nil {
 		return nil, err
 	}
@@ -50,7 +53,7 @@ func (c *Client) GetAllNodes(spaceID string) (*RoomNodes, error) {
 		}
 	}
 
-	roomNodes, err := c.GetRoomNodes(spaceID, allNodesRoomID)
+	roomNodes, err := c.GetRoomNodes(ctx, spaceID, allNodesRoomID)
 	if err != nil {
 		return nil, err
 	}
@@ -58,7 +61,7 @@ func (c *Client) GetAllNodes(spaceID string) (*RoomNodes, error) {
 	return roomNodes, nil
 }
 
-func (c *Client) ListNodeMembershipRules(spaceID, roomID string) ([]NodeMembershipRule, error) {
+func (c *Client) ListNodeMembershipRules(ctx context.Context, spaceID, roomID string) ([]NodeMembershipRule, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
@@ -66,7 +69,7 @@ func (c *Client) ListNodeMembershipRules(spaceID, roomID string) ([]NodeMembersh
 		return nil, ErrRoomIDRequired
 	}
 
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules", c.HostURL, spaceID, roomID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules", c.HostURL, spaceID, roomID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -81,7 +84,7 @@ func (c *Client) ListNodeMembershipRules(spaceID, roomID string) ([]NodeMembersh
 	return nodeMembershipRule, nil
 }
 
-func (c *Client) GetNodeMembershipRule(spaceID, roomID, nodeMembershipID string) (*NodeMembershipRule, error) {
+func (c *Client) GetNodeMembershipRule(ctx context.Context, spaceID, roomID, nodeMembershipID string) (*NodeMembershipRule, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
@@ -92,7 +95,7 @@ func (c *Client) GetNodeMembershipRule(spaceID, roomID, nodeMembershipID string)
 		return nil, ErrNodeMembershipIDRequired
 	}
 
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules/%s", c.HostURL, spaceID, roomID, nodeMembershipID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules/%s", c.HostURL, spaceID, roomID, nodeMembershipID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -107,7 +110,7 @@ func (c *Client) GetNodeMembershipRule(spaceID, roomID, nodeMembershipID string)
 	return &nodeMembershipRule, nil
 }
 
-func (c *Client) CreateNodeMembershipRule(spaceID, roomID, action, description string, clauses []NodeMembershipClause) (*NodeMembershipRule, error) {
+func (c *Client) CreateNodeMembershipRule(ctx context.Context, spaceID, roomID, action, description string, clauses []NodeMembershipClause) (*NodeMembershipRule, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
@@ -127,7 +130,7 @@ func (c *Client) CreateNodeMembershipRule(spaceID, roomID, action, description s
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules", c.HostURL, spaceID, roomID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules", c.HostURL, spaceID, roomID), bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -142,7 +145,7 @@ func (c *Client) CreateNodeMembershipRule(spaceID, roomID, action, description s
 	return &nodeMembershipRule, nil
 }
 
-func (c *Client) UpdateNodeMembershipRule(spaceID, roomID, nodeMembershipID, action, description string, clauses []NodeMembershipClause) (*NodeMembershipRule, error) {
+func (c *Client) UpdateNodeMembershipRule(ctx context.Context, spaceID, roomID, nodeMembershipID, action, description string, clauses []NodeMembershipClause) (*NodeMembershipRule, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
@@ -165,7 +168,7 @@ func (c *Client) UpdateNodeMembershipRule(spaceID, roomID, nodeMembershipID, act
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules/%s", c.HostURL, spaceID, roomID, nodeMembershipID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules/%s", c.HostURL, spaceID, roomID, nodeMembershipID), bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -180,7 +183,7 @@ func (c *Client) UpdateNodeMembershipRule(spaceID, roomID, nodeMembershipID, act
 	return &nodeMembershipRule, nil
 }
 
-func (c *Client) DeleteNodeMembershipRule(spaceID, roomID, nodeMembershipID string) error {
+func (c *Client) DeleteNodeMembershipRule(ctx context.Context, spaceID, roomID, nodeMembershipID string) error {
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
@@ -191,7 +194,7 @@ func (c *Client) DeleteNodeMembershipRule(spaceID, roomID, nodeMembershipID stri
 		return ErrNodeMembershipIDRequired
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules/%s", c.HostURL, spaceID, roomID, nodeMembershipID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v3/spaces/%s/rooms/%s/node-membership-rules/%s", c.HostURL, spaceID, roomID, nodeMembershipID), nil)
 	if err != nil {
 		return err
 	}
@@ -204,7 +207,7 @@ func (c *Client) DeleteNodeMembershipRule(spaceID, roomID, nodeMembershipID stri
 	return nil
 }
 
-func (c *Client) CreateNodeRoomMember(spaceID, roomID, nodeID string) error {
+func (c *Client) CreateNodeRoomMember(ctx context.Context, spaceID, roomID, nodeID string) error {
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
@@ -220,7 +223,7 @@ func (c *Client) CreateNodeRoomMember(spaceID, roomID, nodeID string) error {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v1/spaces/%s/rooms/%s/claimed-nodes", c.HostURL, spaceID, roomID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v1/spaces/%s/rooms/%s/claimed-nodes", c.HostURL, spaceID, roomID), bytes.NewReader(reqBody))
 	if err != nil {
 		return err
 	}
@@ -234,7 +237,7 @@ func (c *Client) CreateNodeRoomMember(spaceID, roomID, nodeID string) error {
 
 }
 
-func (c *Client) DeleteNodeRoomMember(spaceID, roomID, nodeID string) error {
+func (c *Client) DeleteNodeRoomMember(ctx context.Context, spaceID, roomID, nodeID string) error {
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
@@ -245,7 +248,7 @@ func (c *Client) DeleteNodeRoomMember(spaceID, roomID, nodeID string) error {
 		return ErrNodeID
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v1/spaces/%s/rooms/%s/claimed-nodes?node_ids=%s", c.HostURL, spaceID, roomID, nodeID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v1/spaces/%s/rooms/%s/claimed-nodes?node_ids=%s", c.HostURL, spaceID, roomID, nodeID), nil)
 	if err != nil {
 		return err
 	}
@@ -2,12 +2,13 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) CreateDiscordChannel(spaceID string, commonParams NotificationChannel, discordParams NotificationDiscordChannel) (*NotificationChannel, error) {
+func (c *Client) CreateDiscordChannel(ctx context.Context, spaceID string, commonParams NotificationChannel, discordParams NotificationDiscordChannel) (*NotificationChannel, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
@@ -32,7 +33,7 @@ func (c *Client) CreateDiscordChannel(spaceID string, commonParams NotificationC
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), bytes.NewReader(jsonReqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), bytes.NewReader(jsonReqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -44,7 +45,7 @@ func (c *Client) CreateDiscordChannel(spaceID string, commonParams NotificationC
 		return nil, err
 	}
 
-	err = c.EnableChannelByID(spaceID, respNotificationChannel.ID, commonParams.Enabled)
+	err = c.EnableChannelByID(ctx, spaceID, respNotificationChannel.ID, commonParams.Enabled)
 
 	if err != nil {
 		return nil, err
@@ -55,7 +56,7 @@ func (c *Client) CreateDiscordChannel(spaceID string, commonParams NotificationC
 	return &respNotificationChannel, nil
 }
 
-func (c *Client) UpdateDiscordChannelByID(spaceID string, commonParams NotificationChannel, discordParams NotificationDiscordChannel) (*NotificationChannel, error) {
+func (c *Client) UpdateDiscordChannelByID(ctx context.Context, spaceID string, commonParams NotificationChannel, discordParams NotificationDiscordChannel) (*NotificationChannel, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
@@ -65,7 +66,7 @@ func (c *Client) UpdateDiscordChannelByID(spaceID string, commonParams Notificat
 		return nil, ErrChannelIDRequired
 	}
 
-	err := c.EnableChannelByID(spaceID, commonParams.ID, commonParams.Enabled)
+	err := c.EnableChannelByID(ctx, spaceID, commonParams.ID, commonParams.Enabled)
 	if err != nil {
 		return nil, err
 	}
@@ -88,7 +89,7 @@ func (c *Client) UpdateDiscordChannelByID(spaceID string, commonParams Notificat
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, commonParams.ID), bytes.NewReader(jsonReqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, commonParams.ID), bytes.NewReader(jsonReqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -2,12 +2,13 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) CreatePagerdutyChannel(spaceID string, commonParams NotificationChannel, pagerdutyParams NotificationPagerdutyChannel) (*NotificationChannel, error) {
+func (c *Client) CreatePagerdutyChannel(ctx context.Context, spaceID string, commonParams NotificationChannel, pagerdutyParams NotificationPagerdutyChannel) (*NotificationChannel, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
@@ -31,7 +32,7 @@ func (c *Client) CreatePagerdutyChannel(spaceID string, commonParams Notificatio
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), bytes.NewReader(jsonReqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), bytes.NewReader(jsonReqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +44,7 @@ func (c *Client) CreatePagerdutyChannel(spaceID string, commonParams Notificatio
 		return nil, err
 	}
 
-	err = c.EnableChannelByID(spaceID, respNotificationChannel.ID, commonParams.Enabled)
+	err = c.EnableChannelByID(ctx, spaceID, respNotificationChannel.ID, commonParams.Enabled)
 
 	if err != nil {
 		return nil, err
@@ -54,7 +55,7 @@ func (c *Client) CreatePagerdutyChannel(spaceID string, commonParams Notificatio
 	return &respNotificationChannel, nil
 }
 
-func (c *Client) UpdatePagerdutyChannelByID(spaceID string, commonParams NotificationChannel, pagerdutyParams NotificationPagerdutyChannel) (*NotificationChannel, error) {
+func (c *Client) UpdatePagerdutyChannelByID(ctx context.Context, spaceID string, commonParams NotificationChannel, pagerdutyParams NotificationPagerdutyChannel) (*NotificationChannel, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
@@ -64,7 +65,7 @@ func (c *Client) UpdatePagerdutyChannelByID(spaceID string, commonParams Notific
 		return nil, ErrChannelIDRequired
 	}
 
-	err := c.EnableChannelByID(spaceID, commonParams.ID, commonParams.Enabled)
+	err := c.EnableChannelByID(ctx, spaceID, commonParams.ID, commonParams.Enabled)
 	if err != nil {
 		return nil, err
 	}
@@ -87,7 +88,7 @@ func (c *Client) UpdatePagerdutyChannelByID(spaceID string, commonParams Notific
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, commonParams.ID), bytes.NewReader(jsonReqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, commonParams.ID), bytes.NewReader(jsonReqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -2,12 +2,13 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) CreateSlackChannel(spaceID string, commonParams NotificationChannel, slackParams NotificationSlackChannel) (*NotificationChannel, error) {
+func (c *Client) CreateSlackChannel(ctx context.Context, spaceID string, commonParams NotificationChannel, slackParams NotificationSlackChannel) (*NotificationChannel, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
@@ -31,7 +32,7 @@ func (c *Client) CreateSlackChannel(spaceID string, commonParams NotificationCha
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), bytes.NewReader(jsonReqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), bytes.NewReader(jsonReqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -43,7 +44,7 @@ func (c *Client) CreateSlackChannel(spaceID string, commonParams NotificationCha
 		return nil, err
 	}
 
-	err = c.EnableChannelByID(spaceID, respNotificationChannel.ID, commonParams.Enabled)
+	err = c.EnableChannelByID(ctx, spaceID, respNotificationChannel.ID, commonParams.Enabled)
 	if err != nil {
 		return nil, err
 	}
@@ -53,7 +54,7 @@ func (c *Client) CreateSlackChannel(spaceID string, commonParams NotificationCha
 	return &respNotificationChannel, nil
 }
 
-func (c *Client) UpdateSlackChannelByID(spaceID string, commonParams NotificationChannel, slackParams NotificationSlackChannel) (*NotificationChannel, error) {
+func (c *Client) UpdateSlackChannelByID(ctx context.Context, spaceID string, commonParams NotificationChannel, slackParams NotificationSlackChannel) (*NotificationChannel, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
@@ -63,7 +64,7 @@ func (c *Client) UpdateSlackChannelByID(spaceID string, commonParams Notificatio
 		return nil, ErrChannelIDRequired
 	}
 
-	err := c.EnableChannelByID(spaceID, commonParams.ID, commonParams.Enabled)
+	err := c.EnableChannelByID(ctx, spaceID, commonParams.ID, commonParams.Enabled)
 	if err != nil {
 		return nil, err
 	}
@@ -85,7 +86,7 @@ func (c *Client) UpdateSlackChannelByID(spaceID string, commonParams Notificatio
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPut, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, commonParams.ID), bytes.NewReader(jsonReqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, commonParams.ID), bytes.NewReader(jsonReqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,103 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+)
+
+func (c *Client) CreateWebhookChannel(ctx context.Context, spaceID string, commonParams NotificationChannel, webhookParams NotificationWebhookChannel) (*NotificationChannel, error) {
+
+	if spaceID == "" {
+		return nil, ErrSpaceIDRequired
+	}
+
+	reqBody := notificationRequestPayload{
+		Name:                     commonParams.Name,
+		IntegrationID:            commonParams.Integration.ID,
+		Rooms:                    commonParams.Rooms,
+		NotificationOptions:      commonParams.NotificationOptions,
+		RepeatNotificationMinute: commonParams.RepeatNotificationMinute,
+	}
+
+	secretsJson, err := json.Marshal(webhookParams)
+	if err != nil {
+		return nil, err
+	}
+	reqBody.Secrets = json.RawMessage(secretsJson)
+	jsonReqBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), bytes.NewReader(jsonReqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	var respNotificationChannel NotificationChannel
+
+	err = c.doRequestUnmarshal(req, &respNotificationChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.EnableChannelByID(ctx, spaceID, respNotificationChannel.ID, commonParams.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	respNotificationChannel.Enabled = commonParams.Enabled
+
+	return &respNotificationChannel, nil
+}
+
+func (c *Client) UpdateWebhookChannelByID(ctx context.Context, spaceID string, commonParams NotificationChannel, webhookParams NotificationWebhookChannel) (*NotificationChannel, error) {
+
+	if spaceID == "" {
+		return nil, ErrSpaceIDRequired
+	}
+
+	if commonParams.ID == "" {
+		return nil, ErrChannelIDRequired
+	}
+
+	err := c.EnableChannelByID(ctx, spaceID, commonParams.ID, commonParams.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody := notificationRequestPayload{
+		Name:                     commonParams.Name,
+		Rooms:                    commonParams.Rooms,
+		NotificationOptions:      commonParams.NotificationOptions,
+		RepeatNotificationMinute: commonParams.RepeatNotificationMinute,
+	}
+	secretsJson, err := json.Marshal(webhookParams)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody.Secrets = json.RawMessage(secretsJson)
+	jsonReqBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, commonParams.ID), bytes.NewReader(jsonReqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	var respNotificationChannel NotificationChannel
+
+	err = c.doRequestUnmarshal(req, &respNotificationChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respNotificationChannel, nil
+
+}
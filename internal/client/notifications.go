@@ -2,13 +2,14 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 	"strings"
 )
 
-func (c *Client) GetNotificationChannelByIDAndType(spaceID, channelID, typeName string) (*NotificationChannel, error) {
+func (c *Client) GetNotificationChannelByIDAndType(ctx context.Context, spaceID, channelID, typeName string) (*NotificationChannel, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
@@ -18,7 +19,7 @@ func (c *Client) GetNotificationChannelByIDAndType(spaceID, channelID, typeName
 		return nil, ErrChannelIDRequired
 	}
 
-	channels, err := c.GetNotificationChannelByType(spaceID, typeName)
+	channels, err := c.GetNotificationChannelByType(ctx, spaceID, typeName)
 	if err != nil {
 		return nil, err
 	}
@@ -37,7 +38,7 @@ func (c *Client) GetNotificationChannelByIDAndType(spaceID, channelID, typeName
 	}
 
 	// if the channel is found, get the detailed channel information
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, channelID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, channelID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -53,13 +54,30 @@ func (c *Client) GetNotificationChannelByIDAndType(spaceID, channelID, typeName
 
 }
 
-func (c *Client) GetNotificationIntegrationByType(spaceID, typeName string) (*NotificationIntegration, error) {
+func (c *Client) GetNotificationIntegrationByType(ctx context.Context, spaceID, typeName string) (*NotificationIntegration, error) {
+
+	integrations, err := c.GetNotificationIntegrations(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, integration := range integrations {
+		if strings.EqualFold(integration.Name, typeName) {
+			return &integration, nil
+		}
+	}
+
+	return nil, ErrNotFound
+
+}
+
+func (c *Client) GetNotificationIntegrations(ctx context.Context, spaceID string) ([]NotificationIntegration, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
 
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/integrations", c.HostURL, spaceID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/integrations", c.HostURL, spaceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -71,24 +89,43 @@ func (c *Client) GetNotificationIntegrationByType(spaceID, typeName string) (*No
 		return nil, err
 	}
 
-	for _, integration := range integrations.Integrations {
-		if strings.EqualFold(integration.Name, typeName) {
-			integration.Name = strings.ToLower(integration.Name)
-			return &integration, nil
+	for i := range integrations.Integrations {
+		integrations.Integrations[i].Name = strings.ToLower(integrations.Integrations[i].Name)
+	}
+
+	return integrations.Integrations, nil
+
+}
+
+func (c *Client) GetNotificationChannelByType(ctx context.Context, spaceID, typeName string) (*[]NotificationChannel, error) {
+
+	channels, err := c.GetNotificationChannels(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+
+	var result []NotificationChannel
+	for _, channel := range channels {
+		if strings.EqualFold(channel.Integration.Name, typeName) {
+			result = append(result, channel)
 		}
 	}
 
+	if len(result) > 0 {
+		return &result, nil
+	}
+
 	return nil, ErrNotFound
 
 }
 
-func (c *Client) GetNotificationChannelByType(spaceID, typeName string) (*[]NotificationChannel, error) {
+func (c *Client) GetNotificationChannels(ctx context.Context, spaceID string) ([]NotificationChannel, error) {
 
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
 
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -100,23 +137,15 @@ func (c *Client) GetNotificationChannelByType(spaceID, typeName string) (*[]Noti
 		return nil, err
 	}
 
-	var result []NotificationChannel
-	for _, channel := range channels {
-		if strings.EqualFold(channel.Integration.Name, typeName) {
-			channel.Integration.Name = strings.ToLower(channel.Integration.Name)
-			result = append(result, channel)
-		}
-	}
-
-	if len(result) > 0 {
-		return &result, nil
+	for i := range channels {
+		channels[i].Integration.Name = strings.ToLower(channels[i].Integration.Name)
 	}
 
-	return nil, ErrNotFound
+	return channels, nil
 
 }
 
-func (c *Client) EnableChannelByID(spaceID, channelID string, enabled bool) error {
+func (c *Client) EnableChannelByID(ctx context.Context, spaceID, channelID string, enabled bool) error {
 
 	if spaceID == "" {
 		return ErrSpaceIDRequired
@@ -134,7 +163,7 @@ func (c *Client) EnableChannelByID(spaceID, channelID string, enabled bool) erro
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, channelID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, channelID), bytes.NewReader(reqBody))
 	if err != nil {
 		return err
 	}
@@ -147,7 +176,7 @@ func (c *Client) EnableChannelByID(spaceID, channelID string, enabled bool) erro
 	return nil
 }
 
-func (c *Client) DeleteChannelByID(spaceID, channelID string) error {
+func (c *Client) DeleteChannelByID(ctx context.Context, spaceID, channelID string) error {
 
 	if spaceID == "" {
 		return ErrSpaceIDRequired
@@ -157,7 +186,7 @@ func (c *Client) DeleteChannelByID(spaceID, channelID string) error {
 		return ErrChannelIDRequired
 	}
 
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, channelID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, channelID), nil)
 	if err != nil {
 		return err
 	}
@@ -169,3 +198,119 @@ func (c *Client) DeleteChannelByID(spaceID, channelID string) error {
 
 	return nil
 }
+
+func (c *Client) GetNotificationChannelByID(ctx context.Context, spaceID, channelID string) (*NotificationChannel, error) {
+
+	if spaceID == "" {
+		return nil, ErrSpaceIDRequired
+	}
+
+	if channelID == "" {
+		return nil, ErrChannelIDRequired
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, channelID), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var channelDetailed NotificationChannel
+
+	err = c.doRequestUnmarshal(req, &channelDetailed)
+	if err != nil {
+		return nil, err
+	}
+
+	channelDetailed.Integration.Name = strings.ToLower(channelDetailed.Integration.Name)
+
+	return &channelDetailed, nil
+
+}
+
+// CreateChannel creates a notification channel for any integration, the secrets are passed through as is.
+func (c *Client) CreateChannel(ctx context.Context, spaceID string, commonParams NotificationChannel) (*NotificationChannel, error) {
+
+	if spaceID == "" {
+		return nil, ErrSpaceIDRequired
+	}
+
+	reqBody := notificationRequestPayload{
+		Name:                     commonParams.Name,
+		IntegrationID:            commonParams.Integration.ID,
+		Rooms:                    commonParams.Rooms,
+		NotificationOptions:      commonParams.NotificationOptions,
+		RepeatNotificationMinute: commonParams.RepeatNotificationMinute,
+		Secrets:                  commonParams.Secrets,
+	}
+
+	jsonReqBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/channel", c.HostURL, spaceID), bytes.NewReader(jsonReqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	var respNotificationChannel NotificationChannel
+
+	err = c.doRequestUnmarshal(req, &respNotificationChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.EnableChannelByID(ctx, spaceID, respNotificationChannel.ID, commonParams.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	respNotificationChannel.Enabled = commonParams.Enabled
+
+	return &respNotificationChannel, nil
+}
+
+// UpdateChannelByID updates a notification channel of any integration, the secrets are passed through as is.
+func (c *Client) UpdateChannelByID(ctx context.Context, spaceID string, commonParams NotificationChannel) (*NotificationChannel, error) {
+
+	if spaceID == "" {
+		return nil, ErrSpaceIDRequired
+	}
+
+	if commonParams.ID == "" {
+		return nil, ErrChannelIDRequired
+	}
+
+	err := c.EnableChannelByID(ctx, spaceID, commonParams.ID, commonParams.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	reqBody := notificationRequestPayload{
+		Name:                     commonParams.Name,
+		Rooms:                    commonParams.Rooms,
+		NotificationOptions:      commonParams.NotificationOptions,
+		RepeatNotificationMinute: commonParams.RepeatNotificationMinute,
+		Secrets:                  commonParams.Secrets,
+	}
+
+	jsonReqBody, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, fmt.Sprintf("%s/api/v2/spaces/%s/channel/%s", c.HostURL, spaceID, commonParams.ID), bytes.NewReader(jsonReqBody))
+	if err != nil {
+		return nil, err
+	}
+
+	var respNotificationChannel NotificationChannel
+
+	err = c.doRequestUnmarshal(req, &respNotificationChannel)
+	if err != nil {
+		return nil, err
+	}
+
+	return &respNotificationChannel, nil
+
+}
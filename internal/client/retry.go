@@ -0,0 +1,109 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+const retryMinWait = 1 * time.Second
+
+type idempotentKey struct{}
+
+// withIdempotent marks a request as safe to retry even though its method is not idempotent,
+// e.g. POST endpoints that only query data.
+func withIdempotent(req *http.Request) *http.Request {
+	return req.WithContext(context.WithValue(req.Context(), idempotentKey{}, true))
+}
+
+func isIdempotent(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	marked, _ := req.Context().Value(idempotentKey{}).(bool)
+	return marked
+}
+
+// shouldRetry reports whether the attempt failed transiently. Throttled requests (429) are
+// rejected before being processed, so they are retried for any method, while connection
+// errors and gateway failures are retried only when repeating the request is harmless.
+func shouldRetry(req *http.Request, res *http.Response, err error) bool {
+	if req.Context().Err() != nil {
+		return false
+	}
+	if err != nil {
+		return isIdempotent(req)
+	}
+	switch res.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return isIdempotent(req)
+	}
+	return false
+}
+
+// retryWait returns the delay before the next attempt. The Retry-After header takes
+// precedence, otherwise the delay grows exponentially with jitter. Both are capped
+// by RetryMaxWait.
+func (c *Client) retryWait(attempt int, res *http.Response) time.Duration {
+	maxWait := c.RetryMaxWait
+	if maxWait <= 0 {
+		maxWait = DefaultRetryMaxWait
+	}
+
+	if res != nil {
+		if wait, ok := parseRetryAfter(res.Header.Get("Retry-After")); ok {
+			return min(wait, maxWait)
+		}
+	}
+
+	backoff := maxWait
+	if attempt < 32 {
+		backoff = min(retryMinWait<<attempt, maxWait)
+	}
+	// equal jitter keeps at least half of the backoff to avoid retrying in a tight loop
+	return backoff/2 + rand.N(backoff/2+1)
+}
+
+// parseRetryAfter parses the Retry-After header given either in seconds or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0), true
+	}
+	return 0, false
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// withLastError keeps the failure of the last attempt when the context ends while waiting to retry,
+// so the error reports the actual cause and not only the expired deadline.
+func withLastError(err, lastErr error) error {
+	if lastErr == nil {
+		return err
+	}
+	return fmt.Errorf("%w, last attempt: %w", err, lastErr)
+}
@@ -0,0 +1,191 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func newTestClient(url string) *Client {
+	c := NewClient(url, "token")
+	c.RetryMaxWait = time.Millisecond
+	c.SetRateLimit(0, 0)
+	return c
+}
+
+func TestDoRequestRetriesTransientStatus(t *testing.T) {
+	tests := []struct {
+		name     string
+		method   string
+		statuses []int
+		attempts int32
+		wantErr  bool
+	}{
+		{name: "get retried on 429 and 503", method: http.MethodGet, statuses: []int{429, 503, 200}, attempts: 3},
+		{name: "post retried on 429", method: http.MethodPost, statuses: []int{429, 200}, attempts: 2},
+		{name: "post not retried on 503", method: http.MethodPost, statuses: []int{503, 200}, attempts: 1, wantErr: true},
+		{name: "get not retried on 500", method: http.MethodGet, statuses: []int{500, 200}, attempts: 1, wantErr: true},
+		{name: "get gives up after max retries", method: http.MethodGet, statuses: []int{503, 503, 503, 503, 200}, attempts: 4, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var attempts atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				n := attempts.Add(1)
+				w.WriteHeader(tt.statuses[n-1])
+			}))
+			defer server.Close()
+
+			c := newTestClient(server.URL)
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, server.URL, strings.NewReader("{}"))
+			_, err := c.doRequest(req)
+
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := attempts.Load(); got != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, got)
+			}
+		})
+	}
+}
+
+type failingTransport struct {
+	attempts atomic.Int32
+}
+
+func (f *failingTransport) RoundTrip(*http.Request) (*http.Response, error) {
+	f.attempts.Add(1)
+	return nil, errors.New("connection reset")
+}
+
+func TestDoRequestTransportError(t *testing.T) {
+	tests := []struct {
+		name       string
+		method     string
+		idempotent bool
+		attempts   int32
+	}{
+		{name: "get retried", method: http.MethodGet, attempts: DefaultMaxRetries + 1},
+		{name: "post not retried", method: http.MethodPost, attempts: 1},
+		{name: "post marked idempotent retried", method: http.MethodPost, idempotent: true, attempts: DefaultMaxRetries + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			transport := &failingTransport{}
+			c := newTestClient("http://netdata.local")
+			c.HTTPClient = &http.Client{Transport: transport}
+
+			req, _ := http.NewRequestWithContext(context.Background(), tt.method, c.HostURL, strings.NewReader("{}"))
+			if tt.idempotent {
+				req = withIdempotent(req)
+			}
+			if _, err := c.doRequest(req); err == nil {
+				t.Fatal("expected an error")
+			}
+			if got := transport.attempts.Load(); got != tt.attempts {
+				t.Errorf("expected %d attempts, got %d", tt.attempts, got)
+			}
+		})
+	}
+}
+
+func TestDoRequestDeadlineKeepsLastError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	c := newTestClient(server.URL)
+	c.RetryMaxWait = time.Minute
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, server.URL, nil)
+	_, err := c.doRequest(req)
+
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("expected the deadline error, got: %v", err)
+	}
+	if !IsStatus(err, http.StatusServiceUnavailable) {
+		t.Errorf("expected the last 503 error to be kept, got: %v", err)
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := []struct {
+		name   string
+		value  string
+		want   time.Duration
+		wantOK bool
+	}{
+		{name: "empty", value: ""},
+		{name: "seconds", value: "5", want: 5 * time.Second, wantOK: true},
+		{name: "zero seconds", value: "0", want: 0, wantOK: true},
+		{name: "negative seconds", value: "-1"},
+		{name: "invalid", value: "soon"},
+		{name: "past date", value: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat), want: 0, wantOK: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := parseRetryAfter(tt.value)
+			if ok != tt.wantOK || got != tt.want {
+				t.Errorf("parseRetryAfter(%q) = %v, %v, want %v, %v", tt.value, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+
+	got, ok := parseRetryAfter(time.Now().Add(time.Minute).UTC().Format(http.TimeFormat))
+	if !ok || got <= 50*time.Second || got > time.Minute {
+		t.Errorf("expected about a minute for a future date, got %v, %v", got, ok)
+	}
+}
+
+func TestRetryWait(t *testing.T) {
+	c := &Client{RetryMaxWait: 4 * time.Second}
+
+	for attempt := range 40 {
+		wait := c.retryWait(attempt, nil)
+		backoff := min(retryMinWait<<min(attempt, 31), c.RetryMaxWait)
+		if wait < backoff/2 || wait > backoff {
+			t.Errorf("attempt %d: wait %v not within [%v, %v]", attempt, wait, backoff/2, backoff)
+		}
+	}
+
+	res := &http.Response{Header: http.Header{"Retry-After": []string{"2"}}}
+	if wait := c.retryWait(0, res); wait != 2*time.Second {
+		t.Errorf("expected the Retry-After delay, got %v", wait)
+	}
+
+	res = &http.Response{Header: http.Header{"Retry-After": []string{"120"}}}
+	if wait := c.retryWait(0, res); wait != c.RetryMaxWait {
+		t.Errorf("expected the Retry-After delay capped to %v, got %v", c.RetryMaxWait, wait)
+	}
+}
+
+func TestSleepContext(t *testing.T) {
+	if err := sleepContext(context.Background(), time.Millisecond); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(10*time.Millisecond, cancel)
+
+	start := time.Now()
+	err := sleepContext(ctx, time.Hour)
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("sleep not interrupted by the cancellation, took %v", elapsed)
+	}
+}
@@ -2,19 +2,21 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
-func (c *Client) GetRoomMembers(spaceID, roomID string) (*[]RoomMember, error) {
+func (c *Client) GetRoomMembers(ctx context.Context, spaceID, roomID string) (*[]RoomMember, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
 	if roomID == "" {
 		return nil, ErrRoomIDRequired
 	}
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/rooms/%s/members", c.HostURL, spaceID, roomID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/rooms/%s/members", c.HostURL, spaceID, roomID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -29,8 +31,8 @@ func (c *Client) GetRoomMembers(spaceID, roomID string) (*[]RoomMember, error) {
 	return &roomMembers, nil
 }
 
-func (c *Client) GetRoomMemberID(spaceID, roomID, spaceMemberID string) (*RoomMember, error) {
-	roomMembers, err := c.GetRoomMembers(spaceID, roomID)
+func (c *Client) GetRoomMemberID(ctx context.Context, spaceID, roomID, spaceMemberID string) (*RoomMember, error) {
+	roomMembers, err := c.GetRoomMembers(ctx, spaceID, roomID)
 	if err != nil {
 		return nil, err
 	}
@@ -42,22 +44,29 @@ func (c *Client) GetRoomMemberID(spaceID, roomID, spaceMemberID string) (*RoomMe
 	return nil, ErrNotFound
 }
 
-func (c *Client) CreateRoomMember(spaceID, roomID, spaceMemberID string) error {
+func (c *Client) CreateRoomMember(ctx context.Context, spaceID, roomID, spaceMemberID string) error {
+	if spaceMemberID == "" {
+		return ErrMemberIDRequired
+	}
+	return c.CreateRoomMembers(ctx, spaceID, roomID, []string{spaceMemberID})
+}
+
+func (c *Client) CreateRoomMembers(ctx context.Context, spaceID, roomID string, spaceMemberIDs []string) error {
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
 	if roomID == "" {
 		return ErrRoomIDRequired
 	}
-	if spaceMemberID == "" {
+	if len(spaceMemberIDs) == 0 {
 		return ErrMemberIDRequired
 	}
-	reqBody, err := json.Marshal([]string{spaceMemberID})
+	reqBody, err := json.Marshal(spaceMemberIDs)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/rooms/%s/members", c.HostURL, spaceID, roomID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/rooms/%s/members", c.HostURL, spaceID, roomID), bytes.NewReader(reqBody))
 	if err != nil {
 		return err
 	}
@@ -71,17 +80,24 @@ func (c *Client) CreateRoomMember(spaceID, roomID, spaceMemberID string) error {
 
 }
 
-func (c *Client) DeleteRoomMember(spaceID, roomID, spaceMemberID string) error {
+func (c *Client) DeleteRoomMember(ctx context.Context, spaceID, roomID, spaceMemberID string) error {
+	if spaceMemberID == "" {
+		return ErrMemberIDRequired
+	}
+	return c.DeleteRoomMembers(ctx, spaceID, roomID, []string{spaceMemberID})
+}
+
+func (c *Client) DeleteRoomMembers(ctx context.Context, spaceID, roomID string, spaceMemberIDs []string) error {
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
 	if roomID == "" {
 		return ErrRoomIDRequired
 	}
-	if spaceMemberID == "" {
+	if len(spaceMemberIDs) == 0 {
 		return ErrMemberIDRequired
 	}
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v2/spaces/%s/rooms/%s/members?member_ids=%s", c.HostURL, spaceID, roomID, spaceMemberID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v2/spaces/%s/rooms/%s/members?member_ids=%s", c.HostURL, spaceID, roomID, strings.Join(spaceMemberIDs, ",")), nil)
 	if err != nil {
 		return err
 	}
@@ -2,16 +2,17 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) GetRooms(spaceID string) (*[]RoomInfo, error) {
+func (c *Client) GetRooms(ctx context.Context, spaceID string) (*[]RoomInfo, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/rooms", c.HostURL, spaceID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/rooms", c.HostURL, spaceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -26,8 +27,8 @@ func (c *Client) GetRooms(spaceID string) (*[]RoomInfo, error) {
 	return &rooms, nil
 }
 
-func (c *Client) GetRoomByID(id, spaceID string) (*RoomInfo, error) {
-	rooms, err := c.GetRooms(spaceID)
+func (c *Client) GetRoomByID(ctx context.Context, id, spaceID string) (*RoomInfo, error) {
+	rooms, err := c.GetRooms(ctx, spaceID)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +40,7 @@ func (c *Client) GetRoomByID(id, spaceID string) (*RoomInfo, error) {
 	return nil, ErrNotFound
 }
 
-func (c *Client) CreateRoom(spaceID, name, description string) (*RoomInfo, error) {
+func (c *Client) CreateRoom(ctx context.Context, spaceID, name, description string) (*RoomInfo, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
@@ -51,7 +52,7 @@ func (c *Client) CreateRoom(spaceID, name, description string) (*RoomInfo, error
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v1/spaces/%s/rooms", c.HostURL, spaceID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v1/spaces/%s/rooms", c.HostURL, spaceID), bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +70,7 @@ func (c *Client) CreateRoom(spaceID, name, description string) (*RoomInfo, error
 	return &room, nil
 }
 
-func (c *Client) UpdateRoomByID(id, spaceID, name, description string) error {
+func (c *Client) UpdateRoomByID(ctx context.Context, id, spaceID, name, description string) error {
 	if id == "" {
 		return fmt.Errorf("id is empty")
 	}
@@ -83,7 +84,7 @@ func (c *Client) UpdateRoomByID(id, spaceID, name, description string) error {
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/api/v1/spaces/%s/rooms/%s", c.HostURL, spaceID, id), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/api/v1/spaces/%s/rooms/%s", c.HostURL, spaceID, id), bytes.NewReader(reqBody))
 	if err != nil {
 		return err
 	}
@@ -94,14 +95,14 @@ func (c *Client) UpdateRoomByID(id, spaceID, name, description string) error {
 	return nil
 }
 
-func (c *Client) DeleteRoomByID(id, spaceID string) error {
+func (c *Client) DeleteRoomByID(ctx context.Context, id, spaceID string) error {
 	if id == "" {
 		return fmt.Errorf("id is empty")
 	}
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v1/spaces/%s/rooms/%s", c.HostURL, spaceID, id), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v1/spaces/%s/rooms/%s", c.HostURL, spaceID, id), nil)
 	if err != nil {
 		return err
 	}
@@ -2,16 +2,18 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
 )
 
-func (c *Client) GetSpaceMembers(spaceID string) (*[]SpaceMember, error) {
+func (c *Client) GetSpaceMembers(ctx context.Context, spaceID string) (*[]SpaceMember, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/members", c.HostURL, spaceID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v2/spaces/%s/members", c.HostURL, spaceID), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -26,8 +28,8 @@ func (c *Client) GetSpaceMembers(spaceID string) (*[]SpaceMember, error) {
 	return &spaceMembers, nil
 }
 
-func (c *Client) GetSpaceMemberID(spaceID, memberID string) (*SpaceMember, error) {
-	spaceMembers, err := c.GetSpaceMembers(spaceID)
+func (c *Client) GetSpaceMemberID(ctx context.Context, spaceID, memberID string) (*SpaceMember, error) {
+	spaceMembers, err := c.GetSpaceMembers(ctx, spaceID)
 	if err != nil {
 		return nil, err
 	}
@@ -39,7 +41,20 @@ func (c *Client) GetSpaceMemberID(spaceID, memberID string) (*SpaceMember, error
 	return nil, ErrNotFound
 }
 
-func (c *Client) CreateSpaceMember(spaceID, email, role string) (*SpaceMember, error) {
+func (c *Client) GetSpaceMemberByEmail(ctx context.Context, spaceID, email string) (*SpaceMember, error) {
+	spaceMembers, err := c.GetSpaceMembers(ctx, spaceID)
+	if err != nil {
+		return nil, err
+	}
+	for _, spaceMember := range *spaceMembers {
+		if strings.EqualFold(spaceMember.Email, email) {
+			return &spaceMember, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func (c *Client) CreateSpaceMember(ctx context.Context, spaceID, email, role string) (*SpaceMember, error) {
 	if spaceID == "" {
 		return nil, ErrSpaceIDRequired
 	}
@@ -54,7 +69,7 @@ func (c *Client) CreateSpaceMember(spaceID, email, role string) (*SpaceMember, e
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/members", c.HostURL, spaceID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v2/spaces/%s/members", c.HostURL, spaceID), bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +84,7 @@ func (c *Client) CreateSpaceMember(spaceID, email, role string) (*SpaceMember, e
 	return &spaceMember, nil
 }
 
-func (c *Client) UpdateSpaceMemberRoleByID(spaceID, memberID, role string) error {
+func (c *Client) UpdateSpaceMemberRoleByID(ctx context.Context, spaceID, memberID, role string) error {
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
@@ -84,7 +99,7 @@ func (c *Client) UpdateSpaceMemberRoleByID(spaceID, memberID, role string) error
 		return err
 	}
 
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/api/v2/spaces/%s/members/%s", c.HostURL, spaceID, memberID), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/api/v2/spaces/%s/members/%s", c.HostURL, spaceID, memberID), bytes.NewReader(reqBody))
 	if err != nil {
 		return err
 	}
@@ -97,14 +112,21 @@ func (c *Client) UpdateSpaceMemberRoleByID(spaceID, memberID, role string) error
 	return nil
 }
 
-func (c *Client) DeleteSpaceMember(spaceID, memberID string) error {
+func (c *Client) DeleteSpaceMember(ctx context.Context, spaceID, memberID string) error {
+	if memberID == "" {
+		return ErrMemberIDRequired
+	}
+	return c.DeleteSpaceMembers(ctx, spaceID, []string{memberID})
+}
+
+func (c *Client) DeleteSpaceMembers(ctx context.Context, spaceID string, memberIDs []string) error {
 	if spaceID == "" {
 		return ErrSpaceIDRequired
 	}
-	if memberID == "" {
+	if len(memberIDs) == 0 {
 		return ErrMemberIDRequired
 	}
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v2/spaces/%s/members?member_ids=%s", c.HostURL, spaceID, memberID), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v2/spaces/%s/members?member_ids=%s", c.HostURL, spaceID, strings.Join(memberIDs, ",")), nil)
 	if err != nil {
 		return err
 	}
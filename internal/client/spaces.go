@@ -2,13 +2,14 @@ package client
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/http"
 )
 
-func (c *Client) GetSpaces() (*[]SpaceInfo, error) {
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s/api/v3/spaces", c.HostURL), nil)
+func (c *Client) GetSpaces(ctx context.Context) (*[]SpaceInfo, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fmt.Sprintf("%s/api/v3/spaces", c.HostURL), nil)
 	if err != nil {
 		return nil, err
 	}
@@ -23,8 +24,8 @@ func (c *Client) GetSpaces() (*[]SpaceInfo, error) {
 	return &spaces, nil
 }
 
-func (c *Client) GetSpaceByID(id string) (*SpaceInfo, error) {
-	spaces, err := c.GetSpaces()
+func (c *Client) GetSpaceByID(ctx context.Context, id string) (*SpaceInfo, error) {
+	spaces, err := c.GetSpaces(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -36,13 +37,13 @@ func (c *Client) GetSpaceByID(id string) (*SpaceInfo, error) {
 	return nil, ErrNotFound
 }
 
-func (c *Client) CreateSpace(name, description string) (*SpaceInfo, error) {
+func (c *Client) CreateSpace(ctx context.Context, name, description string) (*SpaceInfo, error) {
 	reqBody, err := json.Marshal(map[string]string{"name": name})
 	if err != nil {
 		return nil, err
 	}
 
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v1/spaces", c.HostURL), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v1/spaces", c.HostURL), bytes.NewReader(reqBody))
 	if err != nil {
 		return nil, err
 	}
@@ -54,7 +55,7 @@ func (c *Client) CreateSpace(name, description string) (*SpaceInfo, error) {
 		return nil, err
 	}
 
-	err = c.UpdateSpaceByID(space.ID, name, description)
+	err = c.UpdateSpaceByID(ctx, space.ID, name, description)
 	if err != nil {
 		return nil, err
 	}
@@ -64,7 +65,7 @@ func (c *Client) CreateSpace(name, description string) (*SpaceInfo, error) {
 	return &space, nil
 }
 
-func (c *Client) UpdateSpaceByID(id, name, description string) error {
+func (c *Client) UpdateSpaceByID(ctx context.Context, id, name, description string) error {
 	if id == "" {
 		return fmt.Errorf("id is empty")
 	}
@@ -75,7 +76,7 @@ func (c *Client) UpdateSpaceByID(id, name, description string) error {
 	if err != nil {
 		return err
 	}
-	req, err := http.NewRequest(http.MethodPatch, fmt.Sprintf("%s/api/v1/spaces/%s", c.HostURL, id), bytes.NewReader(reqBody))
+	req, err := http.NewRequestWithContext(ctx, http.MethodPatch, fmt.Sprintf("%s/api/v1/spaces/%s", c.HostURL, id), bytes.NewReader(reqBody))
 	if err != nil {
 		return err
 	}
@@ -86,11 +87,11 @@ func (c *Client) UpdateSpaceByID(id, name, description string) error {
 	return nil
 }
 
-func (c *Client) DeleteSpaceByID(id string) error {
+func (c *Client) DeleteSpaceByID(ctx context.Context, id string) error {
 	if id == "" {
 		return fmt.Errorf("id is empty")
 	}
-	req, err := http.NewRequest(http.MethodDelete, fmt.Sprintf("%s/api/v1/spaces/%s", c.HostURL, id), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, fmt.Sprintf("%s/api/v1/spaces/%s", c.HostURL, id), nil)
 	if err != nil {
 		return err
 	}
@@ -103,11 +104,11 @@ func (c *Client) DeleteSpaceByID(id string) error {
 	return nil
 }
 
-func (c *Client) GetSpaceClaimToken(id string) (*string, error) {
+func (c *Client) GetSpaceClaimToken(ctx context.Context, id string) (*string, error) {
 	if id == "" {
 		return nil, fmt.Errorf("id is empty")
 	}
-	req, err := http.NewRequest(http.MethodPost, fmt.Sprintf("%s/api/v1/spaces/%s/token/rotate", c.HostURL, id), nil)
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, fmt.Sprintf("%s/api/v1/spaces/%s/token/rotate", c.HostURL, id), nil)
 	if err != nil {
 		return nil, err
 	}
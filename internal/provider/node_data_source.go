@@ -0,0 +1,198 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &nodeDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodeDataSource{}
+)
+
+func NewNodeDataSource() datasource.DataSource {
+	return &nodeDataSource{}
+}
+
+type nodeDataSource struct {
+	client *client.Client
+}
+
+type nodeDataSourceModel struct {
+	SpaceID   types.String `tfsdk:"space_id"`
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	State     types.String `tfsdk:"state"`
+	Version   types.String `tfsdk:"version"`
+	OS        types.String `tfsdk:"os"`
+	OSVersion types.String `tfsdk:"os_version"`
+	Labels    types.Map    `tfsdk:"labels"`
+	RoomsID   types.List   `tfsdk:"rooms_id"`
+}
+
+func (s *nodeDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_node"
+}
+
+func (s *nodeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := nodeDataSourceAttributes()
+	attributes["space_id"] = schema.StringAttribute{
+		Description: "The ID of the space",
+		Required:    true,
+	}
+	attributes["id"] = schema.StringAttribute{
+		Description: "The ID of the node. Exactly one of `id` or `name` must be set",
+		Optional:    true,
+		Computed:    true,
+		Validators: []validator.String{
+			stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+		},
+	}
+	attributes["name"] = schema.StringAttribute{
+		Description: "The name of the node. Exactly one of `id` or `name` must be set",
+		Optional:    true,
+		Computed:    true,
+	}
+	attributes["rooms_id"] = schema.ListAttribute{
+		Description: "The IDs of the rooms the node belongs to, rooms the token is not allowed to read are left out",
+		ElementType: types.StringType,
+		Computed:    true,
+	}
+
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to get information about a node of a Netdata Cloud Space.",
+		Attributes:  attributes,
+	}
+}
+
+func (s *nodeDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *nodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nodeDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	allNodes, err := s.client.GetAllNodes(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Node",
+			"Could Not Read Nodes for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	var matches []client.RoomNode
+	for _, node := range allNodes.Nodes {
+		if (!state.ID.IsNull() && node.NodeID == state.ID.ValueString()) ||
+			(!state.Name.IsNull() && node.NodeName == state.Name.ValueString()) {
+			matches = append(matches, node)
+		}
+	}
+
+	lookup := "ID: " + state.ID.ValueString()
+	if state.ID.IsNull() {
+		lookup = "Name: " + state.Name.ValueString()
+	}
+	if len(matches) == 0 {
+		resp.Diagnostics.AddError(
+			"Node Not Found",
+			"No Node Found with "+lookup+" in Space ID: "+state.SpaceID.ValueString(),
+		)
+		return
+	}
+	if len(matches) > 1 {
+		var nodeIDs []string
+		for _, node := range matches {
+			nodeIDs = append(nodeIDs, node.NodeID)
+		}
+		resp.Diagnostics.AddError(
+			"Multiple Nodes Found",
+			fmt.Sprintf("Found %d Nodes with %s in Space ID: %s, IDs: %s. Use the id attribute instead", len(matches), lookup, state.SpaceID.ValueString(), strings.Join(nodeIDs, ", ")),
+		)
+		return
+	}
+
+	node, diags := newNodeDataItemModel(ctx, matches[0])
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// there is no API returning the rooms of a node, so every room is checked,
+	// skipping the ones the token is not allowed to read
+	rooms, err := s.client.GetRooms(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Node",
+			"Could Not Read Rooms for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	roomsID := []string{}
+	for _, room := range *rooms {
+		roomNodes, err := s.client.GetRoomNodes(ctx, state.SpaceID.ValueString(), room.ID)
+		if client.IsStatus(err, http.StatusForbidden) || client.IsStatus(err, http.StatusNotFound) {
+			tflog.Warn(ctx, fmt.Sprintf("Skipping inaccessible room for space_id/room_id: %s/%s err: %v", state.SpaceID.ValueString(), room.ID, err))
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Getting Node",
+				fmt.Sprintf("Could not read nodes for space_id/room_id: %s/%s err: %v", state.SpaceID.ValueString(), room.ID, err.Error()),
+			)
+			return
+		}
+		for _, roomNode := range roomNodes.Nodes {
+			if roomNode.NodeID == node.ID.ValueString() {
+				roomsID = append(roomsID, room.ID)
+				break
+			}
+		}
+	}
+
+	state.ID = node.ID
+	state.Name = node.Name
+	state.State = node.State
+	state.Version = node.Version
+	state.OS = node.OS
+	state.OSVersion = node.OSVersion
+	state.Labels = node.Labels
+	state.RoomsID, diags = types.ListValueFrom(ctx, types.StringType, roomsID)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
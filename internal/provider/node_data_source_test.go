@@ -0,0 +1,84 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNodeDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "netdata_node" "test" {
+						space_id = "%s"
+						name     = "testAccDoesNotExist"
+					}
+					`, getNonCommunitySpaceIDEnv()),
+				ExpectError: regexp.MustCompile("No Node Found with Name: testAccDoesNotExist"),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "netdata_node" "test" {
+						space_id = "%s"
+						id       = "00000000-0000-0000-0000-000000000000"
+						name     = "testAcc"
+					}
+					`, getNonCommunitySpaceIDEnv()),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+		},
+	},
+	)
+}
+
+func TestAccNodeDataSourceLookup(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "netdata_space" "test" {
+						name = "testAccNode"
+					}
+					resource "netdata_room" "test" {
+						space_id = netdata_space.test.id
+						name     = "testAccNode"
+					}
+					resource "netdata_node_room_member" "test" {
+						space_id   = netdata_space.test.id
+						room_id    = netdata_room.test.id
+						node_names = ["netdata-agent"]
+						depends_on = [terraform_data.install_agent]
+					}
+					data "netdata_node" "by_name" {
+						space_id   = netdata_space.test.id
+						name       = "netdata-agent"
+						depends_on = [netdata_node_room_member.test]
+					}
+					data "netdata_node" "by_id" {
+						space_id = netdata_space.test.id
+						id       = data.netdata_node.by_name.id
+					}
+					` + testAccInstallAgentConfig("netdata_space.test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.netdata_node.by_name", "id"),
+					resource.TestCheckResourceAttr("data.netdata_node.by_name", "state", "reachable"),
+					resource.TestCheckResourceAttrSet("data.netdata_node.by_name", "version"),
+					resource.TestCheckTypeSetElemAttrPair("data.netdata_node.by_name", "rooms_id.*", "netdata_room.test", "id"),
+					resource.TestCheckResourceAttr("data.netdata_node.by_id", "name", "netdata-agent"),
+					resource.TestCheckResourceAttr("data.netdata_node.by_id", "state", "reachable"),
+					resource.TestCheckResourceAttrPair("data.netdata_node.by_id", "version", "data.netdata_node.by_name", "version"),
+					resource.TestCheckTypeSetElemAttrPair("data.netdata_node.by_id", "rooms_id.*", "netdata_room.test", "id"),
+					resource.TestCheckResourceAttrPair("data.netdata_node.by_id", "rooms_id.#", "data.netdata_node.by_name", "rooms_id.#"),
+				),
+			},
+		},
+	},
+	)
+}
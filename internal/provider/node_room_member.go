@@ -2,11 +2,14 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -20,10 +23,14 @@ import (
 )
 
 var (
-	_ resource.Resource              = &roomMemberResource{}
-	_ resource.ResourceWithConfigure = &roomMemberResource{}
+	_ resource.Resource                = &nodeRoomMemberResource{}
+	_ resource.ResourceWithConfigure   = &nodeRoomMemberResource{}
+	_ resource.ResourceWithImportState = &nodeRoomMemberResource{}
 )
 
+// importedPrivateStateKey marks a resource just imported, so the next read loads everything from the room.
+const importedPrivateStateKey = "imported"
+
 func NewNodeRoomMemberResource() resource.Resource {
 	return &nodeRoomMemberResource{}
 }
@@ -37,6 +44,7 @@ type nodeRoomMemberResourceModel struct {
 	SpaceID   types.String             `tfsdk:"space_id"`
 	NodeNames types.List               `tfsdk:"node_names"`
 	Rules     []nodeRoomMembershipRule `tfsdk:"rule"`
+	Timeouts  timeouts.Value           `tfsdk:"timeouts"`
 }
 type nodeRoomMembershipRule struct {
 	ID          types.String               `tfsdk:"id"`
@@ -63,6 +71,9 @@ Provides a Netdata Cloud Node Room Member resource. Use this resource to manage
 There are two options to add nodes to the room:
 - providing the node names directly, but only reachable nodes will be added to the room, use node_names attribute for this
 - creating rules that will automatically add nodes to the room based on the rule, use rule block for this
+
+On import, all the rules of the room are loaded, and the room nodes not matching any INCLUDE rule are loaded as node_names.
+The API doesn't tell how a node was added to the room, so a node added by name that also matches an INCLUDE rule is not loaded in node_names.
 `,
 		Attributes: map[string]schema.Attribute{
 			"room_id": schema.StringAttribute{
@@ -91,6 +102,7 @@ There are two options to add nodes to the room:
 			},
 		},
 		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 			"rule": schema.ListNestedBlock{
 				Description: "The node rule to apply to the room. The logical relation between multiple rules is OR. More info [here](https://learn.netdata.cloud/docs/netdata-cloud/spaces-and-rooms/node-rule-based-room-assignment).",
 				NestedObject: schema.NestedBlockObject{
@@ -179,7 +191,16 @@ func (s *nodeRoomMemberResource) Create(ctx context.Context, req resource.Create
 
 	tflog.Info(ctx, fmt.Sprintf("Creating node room member for space_id/room_id/node_names: %s/%s/%s", plan.SpaceID.ValueString(), plan.RoomID.ValueString(), plan.NodeNames.String()))
 
-	allNodes, err := s.client.GetAllNodes(plan.SpaceID.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	allNodes, err := s.client.GetAllNodes(ctx, plan.SpaceID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting All Nodes",
@@ -209,7 +230,7 @@ func (s *nodeRoomMemberResource) Create(ctx context.Context, req resource.Create
 
 	for _, planNode := range planNodes {
 		_, nodeID := checkNodeExists(planNode.ValueString(), allNodes, true)
-		err := s.client.CreateNodeRoomMember(plan.SpaceID.ValueString(), plan.RoomID.ValueString(), nodeID)
+		err := s.client.CreateNodeRoomMember(ctx, plan.SpaceID.ValueString(), plan.RoomID.ValueString(), nodeID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Creating Node Room Member",
@@ -229,7 +250,7 @@ func (s *nodeRoomMemberResource) Create(ctx context.Context, req resource.Create
 				Negate:   clause.Negate.ValueBool(),
 			})
 		}
-		nodeMembershipRule, err := s.client.CreateNodeMembershipRule(plan.SpaceID.ValueString(),
+		nodeMembershipRule, err := s.client.CreateNodeMembershipRule(ctx, plan.SpaceID.ValueString(),
 			plan.RoomID.ValueString(),
 			rule.Action.ValueString(),
 			rule.Description.ValueString(),
@@ -266,8 +287,21 @@ func (s *nodeRoomMemberResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	nodeRoomMember, err := s.client.GetRoomNodes(state.SpaceID.ValueString(), state.RoomID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	nodeRoomMember, err := s.client.GetRoomNodes(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Getting Node Room Member",
 			fmt.Sprintf("Could not read node room member for space_id/room_id/node_names: %s/%s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), state.NodeNames.String(), err.Error()),
@@ -275,6 +309,16 @@ func (s *nodeRoomMemberResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	imported, diags := req.Private.GetKey(ctx, importedPrivateStateKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if len(imported) > 0 {
+		s.readImported(ctx, &state, nodeRoomMember, resp)
+		return
+	}
+
 	stateNodes := make([]types.String, 0, len(state.NodeNames.Elements()))
 	diags = state.NodeNames.ElementsAs(ctx, &stateNodes, false)
 	resp.Diagnostics.Append(diags...)
@@ -292,12 +336,13 @@ func (s *nodeRoomMemberResource) Read(ctx context.Context, req resource.ReadRequ
 
 	state.NodeNames, _ = types.ListValueFrom(ctx, types.StringType, refreshedRoomNodes)
 
-	nodeMembershipRules, err := s.client.ListNodeMembershipRules(state.SpaceID.ValueString(), state.RoomID.ValueString())
+	nodeMembershipRules, err := s.client.ListNodeMembershipRules(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting Node Room Membership Rules",
 			fmt.Sprintf("Could not read node room membership rules for space_id/room_id: %s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), err.Error()),
 		)
+		return
 	}
 
 	var refreshedNodeMembershipRules []nodeRoomMembershipRule
@@ -311,11 +356,14 @@ func (s *nodeRoomMemberResource) Read(ctx context.Context, req resource.ReadRequ
 			}
 		}
 		if ruleExist {
-			nodeMembershipRule, err := s.client.GetNodeMembershipRule(state.SpaceID.ValueString(), state.RoomID.ValueString(), rule.ID.String())
+			nodeMembershipRule, err := s.client.GetNodeMembershipRule(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString(), rule.ID.ValueString())
 			if err != nil {
+				if errors.Is(err, client.ErrNotFound) {
+					continue
+				}
 				resp.Diagnostics.AddError(
 					"Error Getting Node Room Membership Rule",
-					fmt.Sprintf("Could not read node room membership rule for space_id/room_id/rule_id: %s/%s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), rule.ID.String(), err.Error()),
+					fmt.Sprintf("Could not read node room membership rule for space_id/room_id/rule_id: %s/%s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), rule.ID.ValueString(), err.Error()),
 				)
 				return
 			}
@@ -362,7 +410,16 @@ func (s *nodeRoomMemberResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
-	allNodes, err := s.client.GetAllNodes(plan.SpaceID.ValueString())
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	allNodes, err := s.client.GetAllNodes(ctx, plan.SpaceID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting All Nodes",
@@ -407,7 +464,7 @@ func (s *nodeRoomMemberResource) Update(ctx context.Context, req resource.Update
 		if !foundState {
 			exist, nodeID := checkNodeExists(stateNode.ValueString(), allNodes, false)
 			if exist {
-				err := s.client.DeleteNodeRoomMember(state.SpaceID.ValueString(), state.RoomID.ValueString(), nodeID)
+				err := s.client.DeleteNodeRoomMember(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString(), nodeID)
 				if err != nil {
 					resp.Diagnostics.AddError(
 						"Error Deleting Node Room Member",
@@ -421,7 +478,7 @@ func (s *nodeRoomMemberResource) Update(ctx context.Context, req resource.Update
 
 	for _, planNode := range planNodes {
 		_, nodeID := checkNodeExists(planNode.ValueString(), allNodes, true)
-		err := s.client.CreateNodeRoomMember(plan.SpaceID.ValueString(), plan.RoomID.ValueString(), nodeID)
+		err := s.client.CreateNodeRoomMember(ctx, plan.SpaceID.ValueString(), plan.RoomID.ValueString(), nodeID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Creating Node Room Member",
@@ -434,7 +491,7 @@ func (s *nodeRoomMemberResource) Update(ctx context.Context, req resource.Update
 	for _, stateRule := range state.Rules {
 		exist := checkNodeMembershipRule(stateRule.ID.ValueString(), plan.Rules)
 		if !exist {
-			err = s.client.DeleteNodeMembershipRule(state.SpaceID.ValueString(), state.RoomID.ValueString(), stateRule.ID.ValueString())
+			err = s.client.DeleteNodeMembershipRule(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString(), stateRule.ID.ValueString())
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error Deleting Node Membership Rule",
@@ -459,7 +516,7 @@ func (s *nodeRoomMemberResource) Update(ctx context.Context, req resource.Update
 			})
 		}
 		if exist {
-			nodeMembershipRule, err = s.client.UpdateNodeMembershipRule(plan.SpaceID.ValueString(),
+			nodeMembershipRule, err = s.client.UpdateNodeMembershipRule(ctx, plan.SpaceID.ValueString(),
 				plan.RoomID.ValueString(),
 				planRule.ID.ValueString(),
 				planRule.Action.ValueString(),
@@ -473,7 +530,7 @@ func (s *nodeRoomMemberResource) Update(ctx context.Context, req resource.Update
 				return
 			}
 		} else {
-			nodeMembershipRule, err = s.client.CreateNodeMembershipRule(plan.SpaceID.ValueString(),
+			nodeMembershipRule, err = s.client.CreateNodeMembershipRule(ctx, plan.SpaceID.ValueString(),
 				plan.RoomID.ValueString(),
 				planRule.Action.ValueString(),
 				planRule.Description.ValueString(),
@@ -518,8 +575,20 @@ func (s *nodeRoomMemberResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	nodeRoomMember, err := s.client.GetRoomNodes(state.SpaceID.ValueString(), state.RoomID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	nodeRoomMember, err := s.client.GetRoomNodes(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Getting Node Room Member",
 			fmt.Sprintf("Could not read node room member for space_id/room_id/node_names: %s/%s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), state.NodeNames.String(), err.Error()),
@@ -530,7 +599,7 @@ func (s *nodeRoomMemberResource) Delete(ctx context.Context, req resource.Delete
 	for _, stateNode := range stateNodes {
 		exist, nodeID := checkNodeExists(stateNode.ValueString(), nodeRoomMember, false)
 		if exist {
-			err := s.client.DeleteNodeRoomMember(state.SpaceID.ValueString(), state.RoomID.ValueString(), nodeID)
+			err := s.client.DeleteNodeRoomMember(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString(), nodeID)
 			if err != nil {
 				resp.Diagnostics.AddError(
 					"Error Deleting Node Room Member",
@@ -542,8 +611,11 @@ func (s *nodeRoomMemberResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	for _, rule := range state.Rules {
-		err = s.client.DeleteNodeMembershipRule(state.SpaceID.ValueString(), state.RoomID.ValueString(), rule.ID.ValueString())
+		err = s.client.DeleteNodeMembershipRule(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString(), rule.ID.ValueString())
 		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				continue
+			}
 			resp.Diagnostics.AddError(
 				"Error Deleting Node Membership Rule",
 				fmt.Sprintf("Could not delete node membership rule for space_id/room_id/rule_id: %s/%s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), rule.ID.ValueString(), err.Error()),
@@ -566,6 +638,97 @@ func (s *nodeRoomMemberResource) ImportState(ctx context.Context, req resource.I
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("room_id"), idParts[1])...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, []byte("true"))...)
+}
+
+// readImported loads all the membership rules of the room and the nodes assigned to it statically,
+// which are the room nodes not matching any of the INCLUDE rules. A node assigned statically that
+// also matches an INCLUDE rule can't be told apart, so it is left out of node_names.
+func (s *nodeRoomMemberResource) readImported(ctx context.Context, state *nodeRoomMemberResourceModel, roomNodes *client.RoomNodes, resp *resource.ReadResponse) {
+	nodeMembershipRules, err := s.client.ListNodeMembershipRules(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Node Room Membership Rules",
+			fmt.Sprintf("Could not read node room membership rules for space_id/room_id: %s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state.Rules = nil
+	for _, rule := range nodeMembershipRules {
+		var clauses []nodeRoomMembershipClause
+		for _, clause := range rule.Clauses {
+			clauses = append(clauses, nodeRoomMembershipClause{
+				Label:    types.StringValue(clause.Label),
+				Operator: types.StringValue(clause.Operator),
+				Value:    types.StringValue(clause.Value),
+				Negate:   types.BoolValue(clause.Negate),
+			})
+		}
+		state.Rules = append(state.Rules, nodeRoomMembershipRule{
+			ID:          types.StringValue(rule.ID.String()),
+			Action:      types.StringValue(rule.Action),
+			Description: types.StringValue(rule.Description),
+			Clauses:     clauses,
+		})
+	}
+
+	var nodeNames []string
+	for _, node := range roomNodes.Nodes {
+		if !nodeMatchesIncludeRule(node, nodeMembershipRules) {
+			nodeNames = append(nodeNames, node.NodeName)
+		}
+	}
+
+	var diags diag.Diagnostics
+	state.NodeNames = types.ListNull(types.StringType)
+	if len(nodeNames) > 0 {
+		state.NodeNames, diags = types.ListValueFrom(ctx, types.StringType, nodeNames)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, importedPrivateStateKey, nil)...)
+}
+
+func nodeMatchesIncludeRule(node client.RoomNode, rules []client.NodeMembershipRule) bool {
+	for _, rule := range rules {
+		if rule.Action != "INCLUDE" || len(rule.Clauses) == 0 {
+			continue
+		}
+		matches := true
+		for _, clause := range rule.Clauses {
+			if !nodeMatchesClause(node, clause) {
+				matches = false
+				break
+			}
+		}
+		if matches {
+			return true
+		}
+	}
+	return false
+}
+
+func nodeMatchesClause(node client.RoomNode, clause client.NodeMembershipClause) bool {
+	value, ok := node.Labels[clause.Label]
+	var matches bool
+	if ok {
+		switch clause.Operator {
+		case "equals":
+			matches = value == clause.Value
+		case "starts_with":
+			matches = strings.HasPrefix(value, clause.Value)
+		case "ends_with":
+			matches = strings.HasSuffix(value, clause.Value)
+		case "contains":
+			matches = strings.Contains(value, clause.Value)
+		}
+	}
+	return matches != clause.Negate
 }
 
 func checkNodeExists(searchingForNodeName string, nodes *client.RoomNodes, reachableOnly bool) (bool, string) {
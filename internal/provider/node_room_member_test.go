@@ -5,6 +5,8 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
 )
 
 func TestAccNodeRoomMemberResource(t *testing.T) {
@@ -102,6 +104,85 @@ EOT
 					resource.TestCheckResourceAttr("netdata_node_room_member.test", "rule.0.clause.1.negate", "true"),
 				),
 			},
+			{
+				ResourceName:            "netdata_node_room_member.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccNodeRoomMemberImportStateID("netdata_node_room_member.test"),
+				ImportStateVerifyIgnore: []string{"timeouts"},
+			},
 		},
 	})
 }
+
+func testAccNodeRoomMemberImportStateID(resourceName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("resource not found: %s", resourceName)
+		}
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["space_id"], rs.Primary.Attributes["room_id"]), nil
+	}
+}
+
+func TestNodeMatchesClause(t *testing.T) {
+	node := client.RoomNode{Labels: map[string]string{"role": "parent", "environment": "production"}}
+
+	tests := []struct {
+		name   string
+		clause client.NodeMembershipClause
+		want   bool
+	}{
+		{name: "equals", clause: client.NodeMembershipClause{Label: "role", Operator: "equals", Value: "parent"}, want: true},
+		{name: "equals different value", clause: client.NodeMembershipClause{Label: "role", Operator: "equals", Value: "child"}, want: false},
+		{name: "starts_with", clause: client.NodeMembershipClause{Label: "environment", Operator: "starts_with", Value: "prod"}, want: true},
+		{name: "starts_with different prefix", clause: client.NodeMembershipClause{Label: "environment", Operator: "starts_with", Value: "duct"}, want: false},
+		{name: "ends_with", clause: client.NodeMembershipClause{Label: "environment", Operator: "ends_with", Value: "tion"}, want: true},
+		{name: "ends_with different suffix", clause: client.NodeMembershipClause{Label: "environment", Operator: "ends_with", Value: "prod"}, want: false},
+		{name: "contains", clause: client.NodeMembershipClause{Label: "environment", Operator: "contains", Value: "duct"}, want: true},
+		{name: "contains different value", clause: client.NodeMembershipClause{Label: "environment", Operator: "contains", Value: "staging"}, want: false},
+		{name: "unknown operator", clause: client.NodeMembershipClause{Label: "role", Operator: "matches", Value: "parent"}, want: false},
+		{name: "negated match", clause: client.NodeMembershipClause{Label: "role", Operator: "equals", Value: "parent", Negate: true}, want: false},
+		{name: "negated mismatch", clause: client.NodeMembershipClause{Label: "role", Operator: "equals", Value: "child", Negate: true}, want: true},
+		{name: "missing label", clause: client.NodeMembershipClause{Label: "team", Operator: "equals", Value: "sre"}, want: false},
+		{name: "negated missing label", clause: client.NodeMembershipClause{Label: "team", Operator: "equals", Value: "sre", Negate: true}, want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nodeMatchesClause(node, tt.clause); got != tt.want {
+				t.Errorf("nodeMatchesClause(%+v) = %v, want %v", tt.clause, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNodeMatchesIncludeRule(t *testing.T) {
+	node := client.RoomNode{Labels: map[string]string{"role": "parent", "environment": "production"}}
+	parent := client.NodeMembershipClause{Label: "role", Operator: "equals", Value: "parent"}
+	staging := client.NodeMembershipClause{Label: "environment", Operator: "equals", Value: "staging"}
+
+	tests := []struct {
+		name  string
+		rules []client.NodeMembershipRule
+		want  bool
+	}{
+		{name: "no rules", rules: nil, want: false},
+		{name: "all clauses matching", rules: []client.NodeMembershipRule{{Action: "INCLUDE", Clauses: []client.NodeMembershipClause{parent}}}, want: true},
+		{name: "one clause not matching", rules: []client.NodeMembershipRule{{Action: "INCLUDE", Clauses: []client.NodeMembershipClause{parent, staging}}}, want: false},
+		{name: "any rule matching", rules: []client.NodeMembershipRule{
+			{Action: "INCLUDE", Clauses: []client.NodeMembershipClause{staging}},
+			{Action: "INCLUDE", Clauses: []client.NodeMembershipClause{parent}},
+		}, want: true},
+		{name: "exclude rule ignored", rules: []client.NodeMembershipRule{{Action: "EXCLUDE", Clauses: []client.NodeMembershipClause{parent}}}, want: false},
+		{name: "rule without clauses ignored", rules: []client.NodeMembershipRule{{Action: "INCLUDE"}}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nodeMatchesIncludeRule(node, tt.rules); got != tt.want {
+				t.Errorf("nodeMatchesIncludeRule() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,227 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &nodesDataSource{}
+	_ datasource.DataSourceWithConfigure = &nodesDataSource{}
+)
+
+func NewNodesDataSource() datasource.DataSource {
+	return &nodesDataSource{}
+}
+
+type nodesDataSource struct {
+	client *client.Client
+}
+
+type nodesDataSourceModel struct {
+	SpaceID   types.String        `tfsdk:"space_id"`
+	RoomID    types.String        `tfsdk:"room_id"`
+	NameRegex types.String        `tfsdk:"name_regex"`
+	State     types.String        `tfsdk:"state"`
+	Labels    types.Map           `tfsdk:"labels"`
+	Nodes     []nodeDataItemModel `tfsdk:"nodes"`
+}
+
+type nodeDataItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Name      types.String `tfsdk:"name"`
+	State     types.String `tfsdk:"state"`
+	Version   types.String `tfsdk:"version"`
+	OS        types.String `tfsdk:"os"`
+	OSVersion types.String `tfsdk:"os_version"`
+	Labels    types.Map    `tfsdk:"labels"`
+}
+
+// nodeDataSourceAttributes returns the attributes describing a node, shared by the node data sources.
+func nodeDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"id": schema.StringAttribute{
+			Description: "The ID of the node",
+			Computed:    true,
+		},
+		"name": schema.StringAttribute{
+			Description: "The name of the node",
+			Computed:    true,
+		},
+		"state": schema.StringAttribute{
+			Description: "The reachability state of the node, e.g. `reachable`, `stale` or `offline`",
+			Computed:    true,
+		},
+		"version": schema.StringAttribute{
+			Description: "The version of the Netdata Agent running on the node",
+			Computed:    true,
+		},
+		"os": schema.StringAttribute{
+			Description: "The operating system of the node",
+			Computed:    true,
+		},
+		"os_version": schema.StringAttribute{
+			Description: "The operating system version of the node",
+			Computed:    true,
+		},
+		"labels": schema.MapAttribute{
+			Description: "The host labels of the node",
+			ElementType: types.StringType,
+			Computed:    true,
+		},
+	}
+}
+
+func newNodeDataItemModel(ctx context.Context, node client.RoomNode) (nodeDataItemModel, diag.Diagnostics) {
+	labels, diags := types.MapValueFrom(ctx, types.StringType, node.Labels)
+	return nodeDataItemModel{
+		ID:        types.StringValue(node.NodeID),
+		Name:      types.StringValue(node.NodeName),
+		State:     types.StringValue(node.State),
+		Version:   types.StringValue(node.Version),
+		OS:        types.StringValue(node.OS.Name),
+		OSVersion: types.StringValue(node.OS.Version),
+		Labels:    labels,
+	}, diags
+}
+
+func (s *nodesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_nodes"
+}
+
+func (s *nodesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the nodes of a Netdata Cloud Space or Room.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Description: "The ID of the space",
+				Required:    true,
+			},
+			"room_id": schema.StringAttribute{
+				Description: "The ID of the room. If not set, all the nodes of the space are returned",
+				Optional:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Return only the nodes with a name matching this regular expression",
+				Optional:    true,
+			},
+			"state": schema.StringAttribute{
+				Description: "Return only the nodes in this reachability state, e.g. `reachable`",
+				Optional:    true,
+			},
+			"labels": schema.MapAttribute{
+				Description: "Return only the nodes having all of these host labels with the same values",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"nodes": schema.ListNestedAttribute{
+				Description: "The nodes",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: nodeDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+func (s *nodesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *nodesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state nodesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				"Could Not Compile the Regular Expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	var labels map[string]string
+	resp.Diagnostics.Append(state.Labels.ElementsAs(ctx, &labels, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roomNodes *client.RoomNodes
+	var err error
+	if state.RoomID.IsNull() {
+		roomNodes, err = s.client.GetAllNodes(ctx, state.SpaceID.ValueString())
+	} else {
+		roomNodes, err = s.client.GetRoomNodes(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString())
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Nodes",
+			fmt.Sprintf("Could not read nodes for space_id/room_id: %s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state.Nodes = []nodeDataItemModel{}
+	for _, node := range roomNodes.Nodes {
+		if nameRegex != nil && !nameRegex.MatchString(node.NodeName) {
+			continue
+		}
+		if !state.State.IsNull() && !strings.EqualFold(node.State, state.State.ValueString()) {
+			continue
+		}
+		if !nodeHasLabels(node, labels) {
+			continue
+		}
+		item, diags := newNodeDataItemModel(ctx, node)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.Nodes = append(state.Nodes, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func nodeHasLabels(node client.RoomNode, labels map[string]string) bool {
+	for key, value := range labels {
+		if nodeValue, ok := node.Labels[key]; !ok || nodeValue != value {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+func TestAccNodesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "netdata_space" "test" {
+						name = "testAccNodes"
+					}
+					resource "netdata_room" "test" {
+						space_id = netdata_space.test.id
+						name     = "testAccNodes"
+					}
+					data "netdata_nodes" "all" {
+						space_id   = netdata_space.test.id
+						depends_on = [terraform_data.install_agent]
+					}
+					data "netdata_nodes" "room" {
+						space_id   = netdata_space.test.id
+						room_id    = netdata_room.test.id
+						depends_on = [terraform_data.install_agent]
+					}
+					data "netdata_nodes" "name_match" {
+						space_id   = netdata_space.test.id
+						name_regex = "^netdata-agent$"
+						depends_on = [terraform_data.install_agent]
+					}
+					data "netdata_nodes" "name_miss" {
+						space_id   = netdata_space.test.id
+						name_regex = "^testAccDoesNotExist$"
+						depends_on = [terraform_data.install_agent]
+					}
+					data "netdata_nodes" "state_match" {
+						space_id   = netdata_space.test.id
+						state      = "reachable"
+						depends_on = [terraform_data.install_agent]
+					}
+					data "netdata_nodes" "state_miss" {
+						space_id   = netdata_space.test.id
+						state      = "offline"
+						depends_on = [terraform_data.install_agent]
+					}
+					data "netdata_nodes" "labels_match" {
+						space_id = netdata_space.test.id
+						labels = {
+							_os_name = data.netdata_nodes.all.nodes[0].labels["_os_name"]
+						}
+					}
+					data "netdata_nodes" "labels_miss" {
+						space_id = netdata_space.test.id
+						labels = {
+							_os_name = "does-not-exist"
+						}
+						depends_on = [terraform_data.install_agent]
+					}
+					` + testAccInstallAgentConfig("netdata_space.test"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netdata_nodes.all", "nodes.#", "1"),
+					resource.TestCheckResourceAttrSet("data.netdata_nodes.all", "nodes.0.id"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.all", "nodes.0.name", "netdata-agent"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.all", "nodes.0.state", "reachable"),
+					resource.TestCheckResourceAttrSet("data.netdata_nodes.all", "nodes.0.version"),
+					resource.TestCheckResourceAttrSet("data.netdata_nodes.all", "nodes.0.os"),
+					resource.TestCheckResourceAttrSet("data.netdata_nodes.all", "nodes.0.os_version"),
+					resource.TestCheckResourceAttrSet("data.netdata_nodes.all", "nodes.0.labels._os_name"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.room", "nodes.#", "0"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.name_match", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.name_miss", "nodes.#", "0"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.state_match", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.state_miss", "nodes.#", "0"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.labels_match", "nodes.#", "1"),
+					resource.TestCheckResourceAttr("data.netdata_nodes.labels_miss", "nodes.#", "0"),
+				),
+			},
+		},
+	},
+	)
+}
+
+func TestNodeHasLabels(t *testing.T) {
+	node := client.RoomNode{Labels: map[string]string{"role": "parent", "env": "production"}}
+
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "no filter", labels: nil, want: true},
+		{name: "all matching", labels: map[string]string{"role": "parent", "env": "production"}, want: true},
+		{name: "different value", labels: map[string]string{"role": "child"}, want: false},
+		{name: "missing label", labels: map[string]string{"team": "sre"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := nodeHasLabels(node, tt.labels); got != tt.want {
+				t.Errorf("nodeHasLabels(%v) = %v, want %v", tt.labels, got, tt.want)
+			}
+		})
+	}
+}
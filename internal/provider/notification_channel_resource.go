@@ -0,0 +1,372 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ resource.Resource              = &notificationChannelResource{}
+	_ resource.ResourceWithConfigure = &notificationChannelResource{}
+)
+
+func NewNotificationChannelResource() resource.Resource {
+	return &notificationChannelResource{}
+}
+
+type notificationChannelResource struct {
+	client *client.Client
+}
+
+type notificationChannelResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Enabled                  types.Bool     `tfsdk:"enabled"`
+	SpaceID                  types.String   `tfsdk:"space_id"`
+	RoomsID                  types.List     `tfsdk:"rooms_id"`
+	NotificationOptions      types.List     `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64    `tfsdk:"repeat_notification_min"`
+	Integration              types.String   `tfsdk:"integration"`
+	Secrets                  types.String   `tfsdk:"secrets"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (s *notificationChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channel"
+}
+
+func (s *notificationChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	fullSchema := commonNotificationSchema(ctx, "integration")
+	fullSchema.Description = "Resource for managing centralized notifications for any Netdata Cloud integration, including the ones without a dedicated resource. Available only in paid plans."
+	fullSchema.Attributes["integration"] = schema.StringAttribute{
+		Description: "The lowercase slug of the integration, e.g. `telegram`, `mattermost`, `rocketchat` or `awssns`",
+		Required:    true,
+		Validators: []validator.String{
+			stringvalidator.RegexMatches(
+				regexp.MustCompile(`^[a-z0-9_-]+$`),
+				"Integration should be lowercase",
+			),
+		},
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+	fullSchema.Attributes["secrets"] = schema.StringAttribute{
+		Description: "The integration specific configuration as a JSON object, passed to Netdata Cloud as is. Use `jsonencode` to build it",
+		Required:    true,
+		Sensitive:   true,
+		Validators: []validator.String{
+			jsonObjectValidator{},
+		},
+	}
+	resp.Schema = fullSchema
+}
+
+func (s *notificationChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *notificationChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan notificationChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	notificationIntegration, err := s.client.GetNotificationIntegrationByType(ctx, plan.SpaceID.ValueString(), plan.Integration.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("integration"),
+				"Error Creating Notification",
+				fmt.Sprintf("The integration %q is not available in the space %s", plan.Integration.ValueString(), plan.SpaceID.ValueString()),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Creating Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		Name:                     plan.Name.ValueString(),
+		Integration:              *notificationIntegration,
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+		Secrets:                  json.RawMessage(plan.Secrets.ValueString()),
+	}
+
+	notificationChannel, err := s.client.CreateChannel(ctx, plan.SpaceID.ValueString(), commonParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *notificationChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state notificationChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	// the integration is not known after an import, so the channel is read by its ID only
+	notificationChannel, err := s.client.GetNotificationChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Getting Notification",
+			fmt.Sprintf("Could not read notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(notificationChannel.Name)
+	state.Enabled = types.BoolValue(notificationChannel.Enabled)
+	state.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	state.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	state.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+	if notificationChannel.Integration.Name != "" && !strings.EqualFold(state.Integration.ValueString(), notificationChannel.Integration.Name) {
+		state.Integration = types.StringValue(notificationChannel.Integration.Name)
+	}
+	// keep the configured formatting of the secrets unless they were changed outside of Terraform
+	if !jsonEqual(state.Secrets.ValueString(), string(notificationChannel.Secrets)) {
+		state.Secrets = types.StringValue(string(notificationChannel.Secrets))
+	}
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *notificationChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan notificationChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		ID:                       plan.ID.ValueString(),
+		Name:                     plan.Name.ValueString(),
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+		Secrets:                  json.RawMessage(plan.Secrets.ValueString()),
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.UpdateChannelByID(ctx, plan.SpaceID.ValueString(), commonParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Notification",
+			fmt.Sprintf("Could not update notification for space_id/channel_id: %s/%s err: %v", plan.SpaceID.ValueString(), plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (s *notificationChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state notificationChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Notification",
+			fmt.Sprintf("Could not delete notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+func (s *notificationChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: space_id,channel_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+// jsonEqual reports whether both strings hold the same JSON value, ignoring formatting and key order.
+func jsonEqual(a, b string) bool {
+	var va, vb any
+	if err := json.Unmarshal([]byte(a), &va); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(b), &vb); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// jsonObjectValidator validates that a string attribute holds a JSON object.
+type jsonObjectValidator struct{}
+
+func (v jsonObjectValidator) Description(ctx context.Context) string {
+	return "value must be a JSON object"
+}
+
+func (v jsonObjectValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v jsonObjectValidator) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var object map[string]any
+	if err := json.Unmarshal([]byte(req.ConfigValue.ValueString()), &object); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			fmt.Sprintf("The value must be a JSON object, got error: %v", err),
+		)
+		return
+	}
+	if object == nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid JSON Object",
+			"The value must be a JSON object, got: null",
+		)
+	}
+}
@@ -0,0 +1,175 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &notificationChannelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &notificationChannelsDataSource{}
+)
+
+func NewNotificationChannelsDataSource() datasource.DataSource {
+	return &notificationChannelsDataSource{}
+}
+
+type notificationChannelsDataSource struct {
+	client *client.Client
+}
+
+type notificationChannelsDataSourceModel struct {
+	SpaceID     types.String                       `tfsdk:"space_id"`
+	Integration types.String                       `tfsdk:"integration"`
+	RoomID      types.String                       `tfsdk:"room_id"`
+	Channels    []notificationChannelDataItemModel `tfsdk:"channels"`
+}
+
+type notificationChannelDataItemModel struct {
+	ID                       types.String `tfsdk:"id"`
+	Name                     types.String `tfsdk:"name"`
+	Integration              types.String `tfsdk:"integration"`
+	Enabled                  types.Bool   `tfsdk:"enabled"`
+	RoomsID                  types.List   `tfsdk:"rooms_id"`
+	NotificationOptions      types.List   `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64  `tfsdk:"repeat_notification_min"`
+}
+
+func (s *notificationChannelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_channels"
+}
+
+func (s *notificationChannelsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the notification channels of a Netdata Cloud Space.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Description: "The ID of the space",
+				Required:    true,
+			},
+			"integration": schema.StringAttribute{
+				Description: "Return only the channels of this integration slug, e.g. `slack`",
+				Optional:    true,
+			},
+			"room_id": schema.StringAttribute{
+				Description: "Return only the channels applied to this room, including the ones applied to `All rooms`",
+				Optional:    true,
+			},
+			"channels": schema.ListNestedAttribute{
+				Description: "The notification channels",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the notification channel",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the notification channel",
+							Computed:    true,
+						},
+						"integration": schema.StringAttribute{
+							Description: "The integration slug of the notification channel",
+							Computed:    true,
+						},
+						"enabled": schema.BoolAttribute{
+							Description: "The enabled status of the notification channel",
+							Computed:    true,
+						},
+						"rooms_id": schema.ListAttribute{
+							Description: "The list of room IDs of the notification channel. An empty list means `All rooms`",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"notifications": schema.ListAttribute{
+							Description: "The notification options of the notification channel",
+							ElementType: types.StringType,
+							Computed:    true,
+						},
+						"repeat_notification_min": schema.Int64Attribute{
+							Description: "The time interval in minutes for the notification to be repeated, 0 means no repetition",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *notificationChannelsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *notificationChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state notificationChannelsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, err := s.client.GetNotificationChannels(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Notification Channels",
+			"Could Not Read Notification Channels for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	state.Channels = []notificationChannelDataItemModel{}
+	for _, channel := range channels {
+		if !state.Integration.IsNull() && !strings.EqualFold(channel.Integration.Name, state.Integration.ValueString()) {
+			continue
+		}
+
+		// the list doesn't include all the details, so every channel is read separately
+		channelDetailed, err := s.client.GetNotificationChannelByID(ctx, state.SpaceID.ValueString(), channel.ID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Getting Notification Channels",
+				fmt.Sprintf("Could not read notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), channel.ID, err.Error()),
+			)
+			return
+		}
+
+		if !state.RoomID.IsNull() && len(channelDetailed.Rooms) > 0 && !slices.Contains(channelDetailed.Rooms, state.RoomID.ValueString()) {
+			continue
+		}
+
+		item := notificationChannelDataItemModel{
+			ID:                       types.StringValue(channelDetailed.ID),
+			Name:                     types.StringValue(channelDetailed.Name),
+			Integration:              types.StringValue(channel.Integration.Name),
+			Enabled:                  types.BoolValue(channelDetailed.Enabled),
+			RepeatNotificationMinute: types.Int64Value(channelDetailed.RepeatNotificationMinute),
+		}
+		item.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, channelDetailed.Rooms)
+		item.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, channelDetailed.NotificationOptions)
+		state.Channels = append(state.Channels, item)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
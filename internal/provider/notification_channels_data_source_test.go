@@ -0,0 +1,50 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationChannelsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "netdata_room" "test" {
+						space_id = "%s"
+						name     = "testAcc"
+					}
+					resource "netdata_notification_discord_channel" "test" {
+						name           = "discord"
+						enabled        = true
+						space_id       = "%s"
+						rooms_id       = [netdata_room.test.id]
+						notifications  = ["CRITICAL","WARNING","CLEAR"]
+						webhook_url    = "https://discord.com/api/webhooks/0000000000000/XXXXXXXXXXXXXXXXXXXXXXXX"
+						channel_type   = "text"
+					}
+					data "netdata_notification_channels" "test" {
+						space_id    = "%s"
+						integration = "discord"
+						room_id     = netdata_room.test.id
+						depends_on  = [netdata_notification_discord_channel.test]
+					}
+					`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.netdata_notification_channels.test", "channels.*", map[string]string{
+						"name":            "discord",
+						"integration":     "discord",
+						"enabled":         "true",
+						"notifications.#": "3",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.netdata_notification_channels.test", "channels.*.id", "netdata_notification_discord_channel.test", "id"),
+				),
+			},
+		},
+	},
+	)
+}
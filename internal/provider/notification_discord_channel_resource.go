@@ -8,6 +8,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -31,16 +32,17 @@ type discordChannelResource struct {
 }
 
 type discordChannelResourceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Enabled                  types.Bool   `tfsdk:"enabled"`
-	SpaceID                  types.String `tfsdk:"space_id"`
-	RoomsID                  types.List   `tfsdk:"rooms_id"`
-	NotificationOptions      types.List   `tfsdk:"notifications"`
-	RepeatNotificationMinute types.Int64  `tfsdk:"repeat_notification_min"`
-	WebhookURL               types.String `tfsdk:"webhook_url"`
-	ChannelType              types.String `tfsdk:"channel_type"`
-	ChannelThread            types.String `tfsdk:"channel_thread"`
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Enabled                  types.Bool     `tfsdk:"enabled"`
+	SpaceID                  types.String   `tfsdk:"space_id"`
+	RoomsID                  types.List     `tfsdk:"rooms_id"`
+	NotificationOptions      types.List     `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64    `tfsdk:"repeat_notification_min"`
+	WebhookURL               types.String   `tfsdk:"webhook_url"`
+	ChannelType              types.String   `tfsdk:"channel_type"`
+	ChannelThread            types.String   `tfsdk:"channel_thread"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (s *discordChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,7 +50,7 @@ func (s *discordChannelResource) Metadata(ctx context.Context, req resource.Meta
 }
 
 func (s *discordChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	fullSchema := commonNotificationSchema("Discord")
+	fullSchema := commonNotificationSchema(ctx, "Discord")
 	fullSchema.Attributes["webhook_url"] = schema.StringAttribute{
 		Description: "Discord webhook URL",
 		Required:    true,
@@ -106,7 +108,16 @@ func (s *discordChannelResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	notificationIntegration, err := s.client.GetNotificationIntegrationByType(plan.SpaceID.ValueString(), "discord")
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	notificationIntegration, err := s.client.GetNotificationIntegrationByType(ctx, plan.SpaceID.ValueString(), "discord")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Discord Notification",
@@ -142,7 +153,7 @@ func (s *discordChannelResource) Create(ctx context.Context, req resource.Create
 		discordParams.ChannelParams.ThreadName = plan.ChannelThread.ValueString()
 	}
 
-	notificationChannel, err := s.client.CreateDiscordChannel(plan.SpaceID.ValueString(), commonParams, discordParams)
+	notificationChannel, err := s.client.CreateDiscordChannel(ctx, plan.SpaceID.ValueString(), commonParams, discordParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Discord Notification",
@@ -174,7 +185,16 @@ func (s *discordChannelResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
-	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(state.SpaceID.ValueString(), state.ID.ValueString(), "discord")
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(ctx, state.SpaceID.ValueString(), state.ID.ValueString(), "discord")
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -258,7 +278,16 @@ func (s *discordChannelResource) Update(ctx context.Context, req resource.Update
 		discordParams.ChannelParams.ThreadName = plan.ChannelThread.ValueString()
 	}
 
-	notificationChannel, err := s.client.UpdateDiscordChannelByID(plan.SpaceID.ValueString(), commonParams, discordParams)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.UpdateDiscordChannelByID(ctx, plan.SpaceID.ValueString(), commonParams, discordParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Discord Notification",
@@ -291,8 +320,20 @@ func (s *discordChannelResource) Delete(ctx context.Context, req resource.Delete
 		return
 	}
 
-	err := s.client.DeleteChannelByID(state.SpaceID.ValueString(), state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Discord Notification",
 			fmt.Sprintf("Could not delete discord notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
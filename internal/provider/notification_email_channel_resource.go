@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ resource.Resource              = &emailChannelResource{}
+	_ resource.ResourceWithConfigure = &emailChannelResource{}
+)
+
+func NewEmailChannelResource() resource.Resource {
+	return &emailChannelResource{}
+}
+
+// the email integration has no secrets, but the API expects the field to be set
+var emailChannelSecrets = json.RawMessage("{}")
+
+const (
+	// emailChannelOriginalKey keeps the settings of the adopted email notification, restored on destroy
+	emailChannelOriginalKey = "original"
+	// emailChannelCreatedKey marks an email notification created by the resource, deleted on destroy
+	emailChannelCreatedKey = "created"
+)
+
+type emailChannelResource struct {
+	client *client.Client
+}
+
+type emailChannelResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Enabled                  types.Bool     `tfsdk:"enabled"`
+	SpaceID                  types.String   `tfsdk:"space_id"`
+	RoomsID                  types.List     `tfsdk:"rooms_id"`
+	NotificationOptions      types.List     `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64    `tfsdk:"repeat_notification_min"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (s *emailChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_email_channel"
+}
+
+func (s *emailChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	fullSchema := commonNotificationSchema(ctx, "Email")
+	fullSchema.Description = "Resource for managing the email notifications of a space. Netdata Cloud creates an email notification for every space, it is adopted on create instead of creating a second one and its previous settings are restored on destroy. Only one resource must manage the email notification of a space, across all configurations and workspaces: a second resource would adopt it again, saving the settings applied by the first one as the settings to restore. To manage it from another configuration, import it there, an imported email notification is only removed from the state on destroy."
+	resp.Schema = fullSchema
+}
+
+func (s *emailChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *emailChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan emailChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		Name:                     plan.Name.ValueString(),
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+		Secrets:                  emailChannelSecrets,
+	}
+
+	var notificationChannel *client.NotificationChannel
+	var emailChannelID string
+
+	// every space gets an email notification on creation, adopt it instead of creating a duplicate
+	emailChannels, err := s.client.GetNotificationChannelByType(ctx, plan.SpaceID.ValueString(), "email")
+	switch {
+	case err == nil:
+		if len(*emailChannels) > 1 {
+			var emailChannelIDs []string
+			for _, emailChannel := range *emailChannels {
+				emailChannelIDs = append(emailChannelIDs, emailChannel.ID)
+			}
+			resp.Diagnostics.AddError(
+				"Error Creating Email Notification",
+				fmt.Sprintf("The space %s has more than one email notification, import the one to manage instead: %s", plan.SpaceID.ValueString(), strings.Join(emailChannelIDs, ",")),
+			)
+			return
+		}
+		emailChannelID = (*emailChannels)[0].ID
+
+		var original *client.NotificationChannel
+		original, err = s.client.GetNotificationChannelByID(ctx, plan.SpaceID.ValueString(), emailChannelID)
+		if err != nil {
+			break
+		}
+		var originalJSON []byte
+		originalJSON, err = json.Marshal(original)
+		if err != nil {
+			break
+		}
+		resp.Diagnostics.Append(resp.Private.SetKey(ctx, emailChannelOriginalKey, originalJSON)...)
+
+		commonParams.ID = emailChannelID
+		notificationChannel, err = s.client.UpdateChannelByID(ctx, plan.SpaceID.ValueString(), commonParams)
+	case errors.Is(err, client.ErrNotFound):
+		var notificationIntegration *client.NotificationIntegration
+		notificationIntegration, err = s.client.GetNotificationIntegrationByType(ctx, plan.SpaceID.ValueString(), "email")
+		if err == nil {
+			commonParams.Integration = *notificationIntegration
+			notificationChannel, err = s.client.CreateChannel(ctx, plan.SpaceID.ValueString(), commonParams)
+		}
+		if err == nil {
+			resp.Diagnostics.Append(resp.Private.SetKey(ctx, emailChannelCreatedKey, []byte("true"))...)
+		}
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Email Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *emailChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state emailChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(ctx, state.SpaceID.ValueString(), state.ID.ValueString(), "email")
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Getting Email Notification",
+			fmt.Sprintf("Could not read email notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(notificationChannel.Name)
+	state.Enabled = types.BoolValue(notificationChannel.Enabled)
+	state.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	state.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	state.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *emailChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan emailChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		ID:                       plan.ID.ValueString(),
+		Name:                     plan.Name.ValueString(),
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+		Secrets:                  emailChannelSecrets,
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.UpdateChannelByID(ctx, plan.SpaceID.ValueString(), commonParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Email Notification",
+			fmt.Sprintf("Could not update email notification for space_id/channel_id: %s/%s err: %v", plan.SpaceID.ValueString(), plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (s *emailChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state emailChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	originalJSON, diags := req.Private.GetKey(ctx, emailChannelOriginalKey)
+	resp.Diagnostics.Append(diags...)
+	created, diags := req.Private.GetKey(ctx, emailChannelCreatedKey)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var err error
+	switch {
+	case len(originalJSON) > 0:
+		// the email notification of the space is kept, only its previous settings are restored
+		var original client.NotificationChannel
+		err = json.Unmarshal(originalJSON, &original)
+		if err == nil {
+			original.Secrets = emailChannelSecrets
+			_, err = s.client.UpdateChannelByID(ctx, state.SpaceID.ValueString(), original)
+		}
+	case len(created) > 0:
+		err = s.client.DeleteChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
+	default:
+		tflog.Info(ctx, fmt.Sprintf("Removing imported email notification from the state for space_id/channel_id: %s/%s", state.SpaceID.ValueString(), state.ID.ValueString()))
+	}
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Email Notification",
+			fmt.Sprintf("Could not delete email notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+func (s *emailChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: space_id,channel_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+func TestAccEmailNotificationResource(t *testing.T) {
+	// the settings of the email notification of the space before it is adopted, nil if there is none
+	var original *client.NotificationChannel
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		CheckDestroy:             testAccCheckEmailChannelRestored(&original),
+		Steps: []resource.TestStep{
+			{
+				PreConfig: func() {
+					var err error
+					original, err = testAccGetEmailChannel(getNonCommunitySpaceIDEnv())
+					if err != nil {
+						t.Fatalf("could not read the email notification of the space: %v", err)
+					}
+				},
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_email_channel" "test" {
+					name                    = "email"
+					enabled                 = true
+					space_id                = "%s"
+					rooms_id                = [netdata_room.test.id]
+					notifications           = ["CRITICAL","WARNING","CLEAR"]
+					repeat_notification_min = 30
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_email_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "name", "email"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netdata_notification_email_channel.test", "space_id"),
+					resource.TestCheckResourceAttrSet("netdata_notification_email_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "notifications.0", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "notifications.1", "WARNING"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "notifications.2", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "repeat_notification_min", "30"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_email_channel" "test" {
+					name                    = "email"
+					enabled                 = true
+					space_id                = "%s"
+					rooms_id                = [netdata_room.test.id]
+					notifications           = ["CLEAR","CRITICAL"]
+					repeat_notification_min = 60
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_email_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "name", "email"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netdata_notification_email_channel.test", "space_id"),
+					resource.TestCheckResourceAttrSet("netdata_notification_email_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "notifications.0", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "notifications.1", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "repeat_notification_min", "60"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_email_channel" "test" {
+					name                    = "email"
+					enabled                 = false
+					space_id                = "%s"
+					rooms_id                = null
+					notifications           = ["CRITICAL","WARNING","CLEAR"]
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_email_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "name", "email"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "enabled", "false"),
+					resource.TestCheckResourceAttrSet("netdata_notification_email_channel.test", "space_id"),
+					resource.TestCheckNoResourceAttr("netdata_notification_email_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "notifications.0", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "notifications.1", "WARNING"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "notifications.2", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_email_channel.test", "repeat_notification_min", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccGetEmailChannel(spaceID string) (*client.NotificationChannel, error) {
+	ctx := context.Background()
+	c := testAccClient()
+	emailChannels, err := c.GetNotificationChannelByType(ctx, spaceID, "email")
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return c.GetNotificationChannelByID(ctx, spaceID, (*emailChannels)[0].ID)
+}
+
+// testAccCheckEmailChannelRestored checks that an adopted email notification is kept with its previous
+// settings, and that one created by the resource is deleted.
+func testAccCheckEmailChannelRestored(original **client.NotificationChannel) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		current, err := testAccGetEmailChannel(getNonCommunitySpaceIDEnv())
+		if err != nil {
+			return err
+		}
+		if *original == nil {
+			if current != nil {
+				return fmt.Errorf("expected the created email notification %s to be deleted", current.ID)
+			}
+			return nil
+		}
+		if current == nil {
+			return fmt.Errorf("expected the adopted email notification %s to be kept", (*original).ID)
+		}
+		if current.ID != (*original).ID ||
+			current.Name != (*original).Name ||
+			current.Enabled != (*original).Enabled ||
+			current.RepeatNotificationMinute != (*original).RepeatNotificationMinute ||
+			!slices.Equal(current.Rooms, (*original).Rooms) ||
+			!slices.Equal(current.NotificationOptions, (*original).NotificationOptions) {
+			return fmt.Errorf("expected the email notification settings to be restored to %+v, got %+v", **original, *current)
+		}
+		return nil
+	}
+}
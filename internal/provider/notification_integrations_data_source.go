@@ -0,0 +1,118 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &notificationIntegrationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &notificationIntegrationsDataSource{}
+)
+
+func NewNotificationIntegrationsDataSource() datasource.DataSource {
+	return &notificationIntegrationsDataSource{}
+}
+
+type notificationIntegrationsDataSource struct {
+	client *client.Client
+}
+
+type notificationIntegrationsDataSourceModel struct {
+	SpaceID      types.String                           `tfsdk:"space_id"`
+	Integrations []notificationIntegrationDataItemModel `tfsdk:"integrations"`
+}
+
+type notificationIntegrationDataItemModel struct {
+	ID        types.String `tfsdk:"id"`
+	Slug      types.String `tfsdk:"slug"`
+	Available types.Bool   `tfsdk:"available"`
+}
+
+func (s *notificationIntegrationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_integrations"
+}
+
+func (s *notificationIntegrationsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the notification integrations of a Netdata Cloud Space.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Description: "The ID of the space",
+				Required:    true,
+			},
+			"integrations": schema.ListNestedAttribute{
+				Description: "The notification integrations",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the integration",
+							Computed:    true,
+						},
+						"slug": schema.StringAttribute{
+							Description: "The slug of the integration, e.g. `slack`",
+							Computed:    true,
+						},
+						"available": schema.BoolAttribute{
+							Description: "Whether the plan of the space allows to use the integration",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *notificationIntegrationsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *notificationIntegrationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state notificationIntegrationsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	integrations, err := s.client.GetNotificationIntegrations(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Notification Integrations",
+			"Could Not Read Notification Integrations for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	state.Integrations = []notificationIntegrationDataItemModel{}
+	for _, integration := range integrations {
+		state.Integrations = append(state.Integrations, notificationIntegrationDataItemModel{
+			ID:        types.StringValue(integration.ID),
+			Slug:      types.StringValue(integration.Name),
+			Available: types.BoolValue(integration.Available),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,34 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccNotificationIntegrationsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "netdata_notification_integrations" "test" {
+						space_id = "%s"
+					}
+					`, getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.netdata_notification_integrations.test", "integrations.*", map[string]string{
+						"slug":      "slack",
+						"available": "true",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.netdata_notification_integrations.test", "integrations.*", map[string]string{
+						"slug": "email",
+					}),
+				),
+			},
+		},
+	},
+	)
+}
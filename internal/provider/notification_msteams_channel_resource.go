@@ -0,0 +1,302 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ resource.Resource              = &msteamsChannelResource{}
+	_ resource.ResourceWithConfigure = &msteamsChannelResource{}
+)
+
+func NewMSTeamsChannelResource() resource.Resource {
+	return &msteamsChannelResource{}
+}
+
+type msteamsChannelResource struct {
+	client *client.Client
+}
+
+type msteamsChannelResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Enabled                  types.Bool     `tfsdk:"enabled"`
+	SpaceID                  types.String   `tfsdk:"space_id"`
+	RoomsID                  types.List     `tfsdk:"rooms_id"`
+	NotificationOptions      types.List     `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64    `tfsdk:"repeat_notification_min"`
+	WebhookURL               types.String   `tfsdk:"webhook_url"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (s *msteamsChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_msteams_channel"
+}
+
+func (s *msteamsChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	fullSchema := commonNotificationSchema(ctx, "Microsoft Teams")
+	fullSchema.Attributes["webhook_url"] = schema.StringAttribute{
+		Description: "Microsoft Teams incoming webhook URL",
+		Required:    true,
+		Sensitive:   true,
+	}
+	resp.Schema = fullSchema
+}
+
+func (s *msteamsChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *msteamsChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan msteamsChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	notificationIntegration, err := s.client.GetNotificationIntegrationByType(ctx, plan.SpaceID.ValueString(), "msteams")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Microsoft Teams Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		Name:                     plan.Name.ValueString(),
+		Integration:              *notificationIntegration,
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+	}
+
+	msteamsParams := client.NotificationMSTeamsChannel{
+		URL: plan.WebhookURL.ValueString(),
+	}
+
+	notificationChannel, err := s.client.CreateMSTeamsChannel(ctx, plan.SpaceID.ValueString(), commonParams, msteamsParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Microsoft Teams Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *msteamsChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state msteamsChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(ctx, state.SpaceID.ValueString(), state.ID.ValueString(), "msteams")
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Getting Microsoft Teams Notification",
+			fmt.Sprintf("Could not read Microsoft Teams notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	var notificationSecrets client.NotificationMSTeamsChannel
+	err = json.Unmarshal(notificationChannel.Secrets, &notificationSecrets)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Microsoft Teams Notification",
+			fmt.Sprintf("Could not unmarshal Microsoft Teams notification secrets for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	state.Name = types.StringValue(notificationChannel.Name)
+	state.Enabled = types.BoolValue(notificationChannel.Enabled)
+	state.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	state.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	state.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+	state.WebhookURL = types.StringValue(notificationSecrets.URL)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *msteamsChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan msteamsChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		ID:                       plan.ID.ValueString(),
+		Name:                     plan.Name.ValueString(),
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+	}
+
+	msteamsParams := client.NotificationMSTeamsChannel{
+		URL: plan.WebhookURL.ValueString(),
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.UpdateMSTeamsChannelByID(ctx, plan.SpaceID.ValueString(), commonParams, msteamsParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Microsoft Teams Notification",
+			fmt.Sprintf("Could not update Microsoft Teams notification for space_id/channel_id: %s/%s err: %v", plan.SpaceID.ValueString(), plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (s *msteamsChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state msteamsChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Microsoft Teams Notification",
+			fmt.Sprintf("Could not delete Microsoft Teams notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+func (s *msteamsChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: space_id,channel_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
@@ -0,0 +1,102 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccMSTeamsNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_msteams_channel" "test" {
+					name                    = "msteams"
+					enabled                 = true
+					space_id                = "%s"
+					rooms_id                = [netdata_room.test.id]
+					webhook_url             = "https://example.webhook.office.com/webhookb2/00000000-0000-0000-0000-000000000000/IncomingWebhook/XXXXXXXXXXXXXXXXXXXXXXXX"
+					notifications           = ["CRITICAL","WARNING","CLEAR"]
+					repeat_notification_min = 30
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_msteams_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "name", "msteams"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netdata_notification_msteams_channel.test", "space_id"),
+					resource.TestCheckResourceAttrSet("netdata_notification_msteams_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "notifications.0", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "notifications.1", "WARNING"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "notifications.2", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "repeat_notification_min", "30"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "webhook_url", "https://example.webhook.office.com/webhookb2/00000000-0000-0000-0000-000000000000/IncomingWebhook/XXXXXXXXXXXXXXXXXXXXXXXX"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_msteams_channel" "test" {
+					name                    = "msteams"
+					enabled                 = true
+					space_id                = "%s"
+					rooms_id                = [netdata_room.test.id]
+					webhook_url             = "https://example.webhook.office.com/webhookb2/00000000-0000-0000-0000-000000000000/IncomingWebhook/XXXXXXXXXXXXXXXXXXXXXXXX"
+					notifications           = ["CLEAR","CRITICAL"]
+					repeat_notification_min = 60
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_msteams_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "name", "msteams"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netdata_notification_msteams_channel.test", "space_id"),
+					resource.TestCheckResourceAttrSet("netdata_notification_msteams_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "notifications.0", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "notifications.1", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "repeat_notification_min", "60"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "webhook_url", "https://example.webhook.office.com/webhookb2/00000000-0000-0000-0000-000000000000/IncomingWebhook/XXXXXXXXXXXXXXXXXXXXXXXX"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_msteams_channel" "test" {
+					name                    = "msteams"
+					enabled                 = false
+					space_id                = "%s"
+					rooms_id                = null
+					webhook_url             = "https://example.webhook.office.com/webhookb2/10000000-0000-0000-0000-000000000000/IncomingWebhook/XXXXXXXXXXXXXXXXXXXXXXXX"
+					notifications           = ["CRITICAL","WARNING","CLEAR"]
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_msteams_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "name", "msteams"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "enabled", "false"),
+					resource.TestCheckResourceAttrSet("netdata_notification_msteams_channel.test", "space_id"),
+					resource.TestCheckNoResourceAttr("netdata_notification_msteams_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "notifications.0", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "notifications.1", "WARNING"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "notifications.2", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "repeat_notification_min", "0"),
+					resource.TestCheckResourceAttr("netdata_notification_msteams_channel.test", "webhook_url", "https://example.webhook.office.com/webhookb2/10000000-0000-0000-0000-000000000000/IncomingWebhook/XXXXXXXXXXXXXXXXXXXXXXXX"),
+				),
+			},
+		},
+	})
+}
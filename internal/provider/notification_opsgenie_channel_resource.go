@@ -0,0 +1,315 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ resource.Resource              = &opsgenieChannelResource{}
+	_ resource.ResourceWithConfigure = &opsgenieChannelResource{}
+)
+
+func NewOpsgenieChannelResource() resource.Resource {
+	return &opsgenieChannelResource{}
+}
+
+type opsgenieChannelResource struct {
+	client *client.Client
+}
+
+type opsgenieChannelResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Enabled                  types.Bool     `tfsdk:"enabled"`
+	SpaceID                  types.String   `tfsdk:"space_id"`
+	RoomsID                  types.List     `tfsdk:"rooms_id"`
+	NotificationOptions      types.List     `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64    `tfsdk:"repeat_notification_min"`
+	APIKey                   types.String   `tfsdk:"api_key"`
+	APIURL                   types.String   `tfsdk:"api_url"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (s *opsgenieChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_opsgenie_channel"
+}
+
+func (s *opsgenieChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	fullSchema := commonNotificationSchema(ctx, "Opsgenie")
+	fullSchema.Attributes["api_key"] = schema.StringAttribute{
+		Description: "Opsgenie API key",
+		Required:    true,
+		Sensitive:   true,
+	}
+	fullSchema.Attributes["api_url"] = schema.StringAttribute{
+		Description: "Opsgenie API URL. Use `https://api.eu.opsgenie.com` for accounts in the EU region. Default is `https://api.opsgenie.com`",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString("https://api.opsgenie.com"),
+	}
+	resp.Schema = fullSchema
+}
+
+func (s *opsgenieChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *opsgenieChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan opsgenieChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	notificationIntegration, err := s.client.GetNotificationIntegrationByType(ctx, plan.SpaceID.ValueString(), "opsgenie")
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Opsgenie Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		Name:                     plan.Name.ValueString(),
+		Integration:              *notificationIntegration,
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+	}
+
+	opsgenieParams := client.NotificationOpsgenieChannel{
+		APIKey: plan.APIKey.ValueString(),
+		URL:    plan.APIURL.ValueString(),
+	}
+
+	notificationChannel, err := s.client.CreateOpsgenieChannel(ctx, plan.SpaceID.ValueString(), commonParams, opsgenieParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Opsgenie Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *opsgenieChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state opsgenieChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(ctx, state.SpaceID.ValueString(), state.ID.ValueString(), "opsgenie")
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Getting Opsgenie Notification",
+			fmt.Sprintf("Could not read opsgenie notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	var notificationSecrets client.NotificationOpsgenieChannel
+	err = json.Unmarshal(notificationChannel.Secrets, &notificationSecrets)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Opsgenie Notification",
+			fmt.Sprintf("Could not unmarshal opsgenie notification secrets for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	state.Name = types.StringValue(notificationChannel.Name)
+	state.Enabled = types.BoolValue(notificationChannel.Enabled)
+	state.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	state.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	state.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+	state.APIKey = types.StringValue(notificationSecrets.APIKey)
+	state.APIURL = types.StringValue(notificationSecrets.URL)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *opsgenieChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan opsgenieChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		ID:                       plan.ID.ValueString(),
+		Name:                     plan.Name.ValueString(),
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+	}
+
+	opsgenieParams := client.NotificationOpsgenieChannel{
+		APIKey: plan.APIKey.ValueString(),
+		URL:    plan.APIURL.ValueString(),
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.UpdateOpsgenieChannelByID(ctx, plan.SpaceID.ValueString(), commonParams, opsgenieParams)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Opsgenie Notification",
+			fmt.Sprintf("Could not update opsgenie notification for space_id/channel_id: %s/%s err: %v", plan.SpaceID.ValueString(), plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (s *opsgenieChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state opsgenieChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Opsgenie Notification",
+			fmt.Sprintf("Could not delete opsgenie notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+func (s *opsgenieChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: space_id,channel_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
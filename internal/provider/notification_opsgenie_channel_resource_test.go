@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOpsgenieNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_opsgenie_channel" "test" {
+					name                    = "opsgenie"
+					enabled                 = true
+					space_id                = "%s"
+					rooms_id                = [netdata_room.test.id]
+					notifications           = ["CRITICAL","WARNING","CLEAR"]
+					api_key                 = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+					repeat_notification_min = 30
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_opsgenie_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "name", "opsgenie"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netdata_notification_opsgenie_channel.test", "space_id"),
+					resource.TestCheckResourceAttrSet("netdata_notification_opsgenie_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "notifications.0", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "notifications.1", "WARNING"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "notifications.2", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "repeat_notification_min", "30"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "api_url", "https://api.opsgenie.com"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "api_key", "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_opsgenie_channel" "test" {
+					name                    = "opsgenie"
+					enabled                 = true
+					space_id                = "%s"
+					rooms_id                = [netdata_room.test.id]
+					notifications           = ["CLEAR","CRITICAL"]
+					api_key                 = "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"
+					api_url                 = "https://api.eu.opsgenie.com"
+					repeat_notification_min = 60
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_opsgenie_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "name", "opsgenie"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netdata_notification_opsgenie_channel.test", "space_id"),
+					resource.TestCheckResourceAttrSet("netdata_notification_opsgenie_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "notifications.0", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "notifications.1", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "repeat_notification_min", "60"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "api_url", "https://api.eu.opsgenie.com"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "api_key", "XXXXXXXXXXXXXXXXXXXXXXXXXXXXXXXX"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_opsgenie_channel" "test" {
+					name             = "opsgenie"
+					enabled          = false
+					space_id         = "%s"
+					rooms_id         = null
+					notifications    = ["CRITICAL","WARNING","CLEAR"]
+					api_key          = "YYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY"
+					api_url          = "https://api.eu.opsgenie.com"
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_opsgenie_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "name", "opsgenie"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "enabled", "false"),
+					resource.TestCheckResourceAttrSet("netdata_notification_opsgenie_channel.test", "space_id"),
+					resource.TestCheckNoResourceAttr("netdata_notification_opsgenie_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "notifications.0", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "notifications.1", "WARNING"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "notifications.2", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "repeat_notification_min", "0"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "api_url", "https://api.eu.opsgenie.com"),
+					resource.TestCheckResourceAttr("netdata_notification_opsgenie_channel.test", "api_key", "YYYYYYYYYYYYYYYYYYYYYYYYYYYYYYYY"),
+				),
+			},
+		},
+	})
+}
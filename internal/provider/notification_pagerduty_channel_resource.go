@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,15 +29,16 @@ type pagerdutyChannelResource struct {
 }
 
 type pagerdutyChannelResourceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Enabled                  types.Bool   `tfsdk:"enabled"`
-	SpaceID                  types.String `tfsdk:"space_id"`
-	RoomsID                  types.List   `tfsdk:"rooms_id"`
-	NotificationOptions      types.List   `tfsdk:"notifications"`
-	RepeatNotificationMinute types.Int64  `tfsdk:"repeat_notification_min"`
-	AlertEventsURL           types.String `tfsdk:"alert_events_url"`
-	IntegrationKey           types.String `tfsdk:"integration_key"`
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Enabled                  types.Bool     `tfsdk:"enabled"`
+	SpaceID                  types.String   `tfsdk:"space_id"`
+	RoomsID                  types.List     `tfsdk:"rooms_id"`
+	NotificationOptions      types.List     `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64    `tfsdk:"repeat_notification_min"`
+	AlertEventsURL           types.String   `tfsdk:"alert_events_url"`
+	IntegrationKey           types.String   `tfsdk:"integration_key"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (s *pagerdutyChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -44,7 +46,7 @@ func (s *pagerdutyChannelResource) Metadata(ctx context.Context, req resource.Me
 }
 
 func (s *pagerdutyChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	fullSchema := commonNotificationSchema("Pagerduty")
+	fullSchema := commonNotificationSchema(ctx, "Pagerduty")
 	fullSchema.Attributes["alert_events_url"] = schema.StringAttribute{
 		Description: "URL for alert events",
 		Required:    true,
@@ -84,7 +86,16 @@ func (s *pagerdutyChannelResource) Create(ctx context.Context, req resource.Crea
 		return
 	}
 
-	notificationIntegration, err := s.client.GetNotificationIntegrationByType(plan.SpaceID.ValueString(), "pagerduty")
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	notificationIntegration, err := s.client.GetNotificationIntegrationByType(ctx, plan.SpaceID.ValueString(), "pagerduty")
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -114,7 +125,7 @@ func (s *pagerdutyChannelResource) Create(ctx context.Context, req resource.Crea
 		IntegrationKey: plan.IntegrationKey.ValueString(),
 	}
 
-	notificationChannel, err := s.client.CreatePagerdutyChannel(plan.SpaceID.ValueString(), commonParams, pagerdutyParams)
+	notificationChannel, err := s.client.CreatePagerdutyChannel(ctx, plan.SpaceID.ValueString(), commonParams, pagerdutyParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Pagerduty Notification",
@@ -146,7 +157,16 @@ func (s *pagerdutyChannelResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(state.SpaceID.ValueString(), state.ID.ValueString(), "pagerduty")
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(ctx, state.SpaceID.ValueString(), state.ID.ValueString(), "pagerduty")
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -211,7 +231,16 @@ func (s *pagerdutyChannelResource) Update(ctx context.Context, req resource.Upda
 		IntegrationKey: plan.IntegrationKey.ValueString(),
 	}
 
-	notificationChannel, err := s.client.UpdatePagerdutyChannelByID(plan.SpaceID.ValueString(), commonParams, pagerdutyParams)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.UpdatePagerdutyChannelByID(ctx, plan.SpaceID.ValueString(), commonParams, pagerdutyParams)
 
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -245,8 +274,20 @@ func (s *pagerdutyChannelResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	err := s.client.DeleteChannelByID(state.SpaceID.ValueString(), state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Pagerduty Notification",
 			fmt.Sprintf("Could not delete pagerduty notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
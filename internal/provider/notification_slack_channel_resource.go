@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -28,14 +29,15 @@ type slackChannelResource struct {
 }
 
 type slackChannelResourceModel struct {
-	ID                       types.String `tfsdk:"id"`
-	Name                     types.String `tfsdk:"name"`
-	Enabled                  types.Bool   `tfsdk:"enabled"`
-	SpaceID                  types.String `tfsdk:"space_id"`
-	RoomsID                  types.List   `tfsdk:"rooms_id"`
-	NotificationOptions      types.List   `tfsdk:"notifications"`
-	RepeatNotificationMinute types.Int64  `tfsdk:"repeat_notification_min"`
-	WebhookURL               types.String `tfsdk:"webhook_url"`
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Enabled                  types.Bool     `tfsdk:"enabled"`
+	SpaceID                  types.String   `tfsdk:"space_id"`
+	RoomsID                  types.List     `tfsdk:"rooms_id"`
+	NotificationOptions      types.List     `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64    `tfsdk:"repeat_notification_min"`
+	WebhookURL               types.String   `tfsdk:"webhook_url"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (s *slackChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -43,7 +45,7 @@ func (s *slackChannelResource) Metadata(ctx context.Context, req resource.Metada
 }
 
 func (s *slackChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
-	fullSchema := commonNotificationSchema("Slack")
+	fullSchema := commonNotificationSchema(ctx, "Slack")
 	fullSchema.Attributes["webhook_url"] = schema.StringAttribute{
 		Description: "Slack webhook URL",
 		Required:    true,
@@ -79,7 +81,16 @@ func (s *slackChannelResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	notificationIntegration, err := s.client.GetNotificationIntegrationByType(plan.SpaceID.ValueString(), "slack")
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	notificationIntegration, err := s.client.GetNotificationIntegrationByType(ctx, plan.SpaceID.ValueString(), "slack")
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Slack Notification",
@@ -107,7 +118,7 @@ func (s *slackChannelResource) Create(ctx context.Context, req resource.CreateRe
 		URL: plan.WebhookURL.ValueString(),
 	}
 
-	notificationChannel, err := s.client.CreateSlackChannel(plan.SpaceID.ValueString(), commonParams, slackParams)
+	notificationChannel, err := s.client.CreateSlackChannel(ctx, plan.SpaceID.ValueString(), commonParams, slackParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Slack Notification",
@@ -139,7 +150,16 @@ func (s *slackChannelResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(state.SpaceID.ValueString(), state.ID.ValueString(), "slack")
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(ctx, state.SpaceID.ValueString(), state.ID.ValueString(), "slack")
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -202,7 +222,16 @@ func (s *slackChannelResource) Update(ctx context.Context, req resource.UpdateRe
 		URL: plan.WebhookURL.ValueString(),
 	}
 
-	notificationChannel, err := s.client.UpdateSlackChannelByID(plan.SpaceID.ValueString(), commonParams, slackParams)
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.UpdateSlackChannelByID(ctx, plan.SpaceID.ValueString(), commonParams, slackParams)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Slack Notification",
@@ -235,8 +264,20 @@ func (s *slackChannelResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	err := s.client.DeleteChannelByID(state.SpaceID.ValueString(), state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Slack Notification",
 			fmt.Sprintf("Could not delete slack notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
@@ -0,0 +1,413 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ resource.Resource                   = &webhookChannelResource{}
+	_ resource.ResourceWithConfigure      = &webhookChannelResource{}
+	_ resource.ResourceWithValidateConfig = &webhookChannelResource{}
+)
+
+func NewWebhookChannelResource() resource.Resource {
+	return &webhookChannelResource{}
+}
+
+type webhookChannelResource struct {
+	client *client.Client
+}
+
+type webhookChannelResourceModel struct {
+	ID                       types.String   `tfsdk:"id"`
+	Name                     types.String   `tfsdk:"name"`
+	Enabled                  types.Bool     `tfsdk:"enabled"`
+	SpaceID                  types.String   `tfsdk:"space_id"`
+	RoomsID                  types.List     `tfsdk:"rooms_id"`
+	NotificationOptions      types.List     `tfsdk:"notifications"`
+	RepeatNotificationMinute types.Int64    `tfsdk:"repeat_notification_min"`
+	WebhookURL               types.String   `tfsdk:"webhook_url"`
+	HTTPHeaders              types.Map      `tfsdk:"http_headers"`
+	AuthMethod               types.String   `tfsdk:"auth_method"`
+	Username                 types.String   `tfsdk:"username"`
+	Password                 types.String   `tfsdk:"password"`
+	BearerToken              types.String   `tfsdk:"bearer_token"`
+	Timeouts                 timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (s *webhookChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification_webhook_channel"
+}
+
+func (s *webhookChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	fullSchema := commonNotificationSchema(ctx, "Webhook")
+	fullSchema.Attributes["webhook_url"] = schema.StringAttribute{
+		Description: "The URL of the webhook receiving the notifications",
+		Required:    true,
+		Sensitive:   true,
+	}
+	fullSchema.Attributes["http_headers"] = schema.MapAttribute{
+		Description: "Extra HTTP headers sent with every notification",
+		ElementType: types.StringType,
+		Optional:    true,
+		Sensitive:   true,
+	}
+	fullSchema.Attributes["auth_method"] = schema.StringAttribute{
+		Description: "The authentication method of the webhook. Valid values are: `mtls` (Netdata Cloud presents its client certificate), `basic`, `bearer`, `none`. Default is `mtls`",
+		Optional:    true,
+		Computed:    true,
+		Default:     stringdefault.StaticString("mtls"),
+		Validators: []validator.String{
+			stringvalidator.OneOf([]string{"mtls", "basic", "bearer", "none"}...),
+		},
+	}
+	fullSchema.Attributes["username"] = schema.StringAttribute{
+		Description: "The username for the `basic` authentication method",
+		Optional:    true,
+	}
+	fullSchema.Attributes["password"] = schema.StringAttribute{
+		Description: "The password for the `basic` authentication method",
+		Optional:    true,
+		Sensitive:   true,
+	}
+	fullSchema.Attributes["bearer_token"] = schema.StringAttribute{
+		Description: "The token for the `bearer` authentication method",
+		Optional:    true,
+		Sensitive:   true,
+	}
+	resp.Schema = fullSchema
+}
+
+func (s *webhookChannelResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config webhookChannelResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	switch config.AuthMethod.ValueString() {
+	case "basic":
+		if config.Username.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("username"),
+				"Missing Webhook Authentication",
+				"username is required if auth_method is basic",
+			)
+		}
+		if config.Password.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("password"),
+				"Missing Webhook Authentication",
+				"password is required if auth_method is basic",
+			)
+		}
+	case "bearer":
+		if config.BearerToken.IsNull() {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("bearer_token"),
+				"Missing Webhook Authentication",
+				"bearer_token is required if auth_method is bearer",
+			)
+		}
+	}
+}
+
+func (s *webhookChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *webhookChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan webhookChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	webhookParams, diags := webhookChannelParams(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	notificationIntegration, err := s.client.GetNotificationIntegrationByType(ctx, plan.SpaceID.ValueString(), "webhook")
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Webhook Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		Name:                     plan.Name.ValueString(),
+		Integration:              *notificationIntegration,
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+	}
+
+	notificationChannel, err := s.client.CreateWebhookChannel(ctx, plan.SpaceID.ValueString(), commonParams, webhookParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Webhook Notification",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *webhookChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state webhookChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.GetNotificationChannelByIDAndType(ctx, state.SpaceID.ValueString(), state.ID.ValueString(), "webhook")
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Getting Webhook Notification",
+			fmt.Sprintf("Could not read webhook notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	var notificationSecrets client.NotificationWebhookChannel
+	err = json.Unmarshal(notificationChannel.Secrets, &notificationSecrets)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Webhook Notification",
+			fmt.Sprintf("Could not unmarshal webhook notification secrets for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+	state.Name = types.StringValue(notificationChannel.Name)
+	state.Enabled = types.BoolValue(notificationChannel.Enabled)
+	state.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	state.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	state.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+	state.WebhookURL = types.StringValue(notificationSecrets.URL)
+	state.AuthMethod = types.StringValue(notificationSecrets.AuthMethod)
+	if len(notificationSecrets.HTTPHeaders) > 0 {
+		state.HTTPHeaders, _ = types.MapValueFrom(ctx, types.StringType, notificationSecrets.HTTPHeaders)
+	} else {
+		state.HTTPHeaders = types.MapNull(types.StringType)
+	}
+	state.Username = stringValueOrNull(notificationSecrets.Username)
+	state.Password = stringValueOrNull(notificationSecrets.Password)
+	state.BearerToken = stringValueOrNull(notificationSecrets.BearerToken)
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *webhookChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan webhookChannelResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var roomsID []string
+	plan.RoomsID.ElementsAs(ctx, &roomsID, false)
+
+	var notificationOptions []string
+	plan.NotificationOptions.ElementsAs(ctx, &notificationOptions, false)
+
+	commonParams := client.NotificationChannel{
+		ID:                       plan.ID.ValueString(),
+		Name:                     plan.Name.ValueString(),
+		Rooms:                    roomsID,
+		NotificationOptions:      notificationOptions,
+		Enabled:                  plan.Enabled.ValueBool(),
+		RepeatNotificationMinute: plan.RepeatNotificationMinute.ValueInt64(),
+	}
+
+	webhookParams, diags := webhookChannelParams(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	notificationChannel, err := s.client.UpdateWebhookChannelByID(ctx, plan.SpaceID.ValueString(), commonParams, webhookParams)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Webhook Notification",
+			fmt.Sprintf("Could not update webhook notification for space_id/channel_id: %s/%s err: %v", plan.SpaceID.ValueString(), plan.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(notificationChannel.ID)
+	plan.Name = types.StringValue(notificationChannel.Name)
+	plan.Enabled = types.BoolValue(notificationChannel.Enabled)
+	plan.RoomsID, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.Rooms)
+	plan.NotificationOptions, _ = types.ListValueFrom(ctx, types.StringType, notificationChannel.NotificationOptions)
+	plan.RepeatNotificationMinute = types.Int64Value(notificationChannel.RepeatNotificationMinute)
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (s *webhookChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state webhookChannelResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteChannelByID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Webhook Notification",
+			fmt.Sprintf("Could not delete webhook notification for space_id/channel_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+func (s *webhookChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: space_id,channel_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
+}
+
+func webhookChannelParams(ctx context.Context, plan webhookChannelResourceModel) (client.NotificationWebhookChannel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	webhookParams := client.NotificationWebhookChannel{
+		URL:        plan.WebhookURL.ValueString(),
+		AuthMethod: plan.AuthMethod.ValueString(),
+	}
+
+	if !plan.HTTPHeaders.IsNull() {
+		diags.Append(plan.HTTPHeaders.ElementsAs(ctx, &webhookParams.HTTPHeaders, false)...)
+	}
+
+	// the credentials required by the auth method are checked in ValidateConfig
+	switch webhookParams.AuthMethod {
+	case "basic":
+		webhookParams.Username = plan.Username.ValueString()
+		webhookParams.Password = plan.Password.ValueString()
+	case "bearer":
+		webhookParams.BearerToken = plan.BearerToken.ValueString()
+	}
+
+	return webhookParams, diags
+}
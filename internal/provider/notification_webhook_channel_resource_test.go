@@ -0,0 +1,122 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWebhookNotificationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_notification_webhook_channel" "test" {
+					name        = "webhook"
+					space_id    = "%s"
+					webhook_url = "https://example.com/netdata/alerts"
+					auth_method = "basic"
+					username    = "netdata"
+				}
+				`, getNonCommunitySpaceIDEnv()),
+				ExpectError: regexp.MustCompile("password is required if auth_method is basic"),
+				PlanOnly:    true,
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_webhook_channel" "test" {
+					name                    = "webhook"
+					enabled                 = true
+					space_id                = "%s"
+					rooms_id                = [netdata_room.test.id]
+					webhook_url             = "https://example.com/netdata/alerts"
+					notifications           = ["CRITICAL","WARNING","CLEAR"]
+					repeat_notification_min = 30
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_webhook_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "name", "webhook"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "enabled", "true"),
+					resource.TestCheckResourceAttrSet("netdata_notification_webhook_channel.test", "space_id"),
+					resource.TestCheckResourceAttrSet("netdata_notification_webhook_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "notifications.0", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "notifications.1", "WARNING"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "notifications.2", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "repeat_notification_min", "30"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "webhook_url", "https://example.com/netdata/alerts"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "auth_method", "mtls"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_webhook_channel" "test" {
+					name                    = "webhook"
+					enabled                 = true
+					space_id                = "%s"
+					rooms_id                = [netdata_room.test.id]
+					webhook_url             = "https://example.com/netdata/alerts"
+					notifications           = ["CLEAR","CRITICAL"]
+					repeat_notification_min = 60
+					auth_method             = "basic"
+					username                = "netdata"
+					password                = "secret"
+					http_headers = {
+						"X-Team" = "sre"
+					}
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_webhook_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "notifications.0", "CLEAR"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "notifications.1", "CRITICAL"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "repeat_notification_min", "60"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "auth_method", "basic"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "username", "netdata"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "password", "secret"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "http_headers.X-Team", "sre"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_notification_webhook_channel" "test" {
+					name                    = "webhook"
+					enabled                 = false
+					space_id                = "%s"
+					rooms_id                = null
+					webhook_url             = "https://example.com/netdata/alerts"
+					notifications           = ["CRITICAL","WARNING","CLEAR"]
+					auth_method             = "bearer"
+					bearer_token            = "token"
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_notification_webhook_channel.test", "id"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "enabled", "false"),
+					resource.TestCheckNoResourceAttr("netdata_notification_webhook_channel.test", "rooms_id.0"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "repeat_notification_min", "0"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "auth_method", "bearer"),
+					resource.TestCheckResourceAttr("netdata_notification_webhook_channel.test", "bearer_token", "token"),
+					resource.TestCheckNoResourceAttr("netdata_notification_webhook_channel.test", "username"),
+					resource.TestCheckNoResourceAttr("netdata_notification_webhook_channel.test", "http_headers.%"),
+				),
+			},
+		},
+	})
+}
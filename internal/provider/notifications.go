@@ -1,8 +1,10 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -14,7 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
-func commonNotificationSchema(notificationType string) schema.Schema {
+func commonNotificationSchema(ctx context.Context, notificationType string) schema.Schema {
 	return schema.Schema{
 		Description: fmt.Sprintf("Resource for managing centralized notifications for %s. Available only in paid plans.", notificationType),
 		Attributes: map[string]schema.Attribute{
@@ -71,5 +73,16 @@ func commonNotificationSchema(notificationType string) schema.Schema {
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+// stringValueOrNull maps the empty secrets returned by the API to null, so unset optional attributes don't produce a diff.
+func stringValueOrNull(value string) types.String {
+	if value == "" {
+		return types.StringNull()
 	}
+	return types.StringValue(value)
 }
@@ -2,13 +2,21 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"math"
 	"os"
+	"strconv"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/netdata/terraform-provider-netdata/internal/client"
 )
@@ -17,13 +25,21 @@ var _ provider.Provider = &netdataCloudProvider{}
 
 const NetdataCloudURL = "https://app.netdata.cloud"
 
+// defaultOperationTimeout bounds a resource operation, including retries, unless overridden in its timeouts block.
+const defaultOperationTimeout = 20 * time.Minute
+
 type netdataCloudProvider struct {
 	version string
 }
 
 type netdataCloudProviderModel struct {
-	Url       types.String `tfsdk:"url"`
-	AuthToken types.String `tfsdk:"auth_token"`
+	Url               types.String  `tfsdk:"url"`
+	AuthToken         types.String  `tfsdk:"auth_token"`
+	HTTPTimeout       types.Int64   `tfsdk:"http_timeout"`
+	MaxRetries        types.Int64   `tfsdk:"max_retries"`
+	RetryMaxWait      types.Int64   `tfsdk:"retry_max_wait"`
+	RequestsPerSecond types.Float64 `tfsdk:"requests_per_second"`
+	Burst             types.Int64   `tfsdk:"burst"`
 }
 
 func (p *netdataCloudProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -44,6 +60,41 @@ func (p *netdataCloudProvider) Schema(ctx context.Context, req provider.SchemaRe
 				Sensitive:           true,
 				Optional:            true,
 			},
+			"http_timeout": schema.Int64Attribute{
+				MarkdownDescription: "Timeout in seconds of a single HTTP request to Netdata Cloud. The whole resource operation, including retries, is bounded by the resource `timeouts` block. By default is 10. Can be also set as environment variable `NETDATA_CLOUD_HTTP_TIMEOUT`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for requests failed with a transient error (`429`, `502`, `503`, `504` or a connection error), `0` disables retries. By default is 3. Can be also set as environment variable `NETDATA_CLOUD_MAX_RETRIES`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_max_wait": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait between retries, it also caps the `Retry-After` header sent by Netdata Cloud. By default is 30. Can be also set as environment variable `NETDATA_CLOUD_RETRY_MAX_WAIT`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"requests_per_second": schema.Float64Attribute{
+				MarkdownDescription: "Maximum rate of requests sent to Netdata Cloud, shared by all resources and data sources, `0` disables the limit. By default is 10. Can be also set as environment variable `NETDATA_CLOUD_REQUESTS_PER_SECOND`",
+				Optional:            true,
+				Validators: []validator.Float64{
+					float64validator.AtLeast(0),
+				},
+			},
+			"burst": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of requests allowed to exceed `requests_per_second` at once. By default is 10. Can be also set as environment variable `NETDATA_CLOUD_BURST`",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
 		},
 	}
 }
@@ -80,7 +131,21 @@ func (p *netdataCloudProvider) Configure(ctx context.Context, req provider.Confi
 		)
 	}
 
+	httpTimeout := int64Setting(data.HTTPTimeout, "NETDATA_CLOUD_HTTP_TIMEOUT", int64(client.DefaultHTTPTimeout/time.Second), 1, path.Root("http_timeout"), &resp.Diagnostics)
+	maxRetries := int64Setting(data.MaxRetries, "NETDATA_CLOUD_MAX_RETRIES", client.DefaultMaxRetries, 0, path.Root("max_retries"), &resp.Diagnostics)
+	retryMaxWait := int64Setting(data.RetryMaxWait, "NETDATA_CLOUD_RETRY_MAX_WAIT", int64(client.DefaultRetryMaxWait/time.Second), 1, path.Root("retry_max_wait"), &resp.Diagnostics)
+	requestsPerSecond := float64Setting(data.RequestsPerSecond, "NETDATA_CLOUD_REQUESTS_PER_SECOND", client.DefaultRequestsPerSecond, 0, path.Root("requests_per_second"), &resp.Diagnostics)
+	burst := int64Setting(data.Burst, "NETDATA_CLOUD_BURST", client.DefaultBurst, 1, path.Root("burst"), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	client := client.NewClient(url, auth_token)
+	client.HTTPClient.Timeout = time.Duration(httpTimeout) * time.Second
+	client.MaxRetries = int(maxRetries)
+	client.RetryMaxWait = time.Duration(retryMaxWait) * time.Second
+	client.SetRateLimit(requestsPerSecond, int(burst))
 
 	resp.DataSourceData = client
 	resp.ResourceData = client
@@ -91,10 +156,19 @@ func (p *netdataCloudProvider) Resources(ctx context.Context) []func() resource.
 		NewSpaceResource,
 		NewRoomResource,
 		NewSpaceMemberResource,
+		NewSpaceMembersResource,
+		NewSpaceInvitationResource,
 		NewRoomMemberResource,
+		NewRoomMembersResource,
 		NewSlackChannelResource,
 		NewDiscordChannelResource,
 		NewPagerdutyChannelResource,
+		NewOpsgenieChannelResource,
+		NewMSTeamsChannelResource,
+		NewTelegramChannelResource,
+		NewEmailChannelResource,
+		NewWebhookChannelResource,
+		NewNotificationChannelResource,
 		NewNodeRoomMemberResource,
 	}
 }
@@ -102,8 +176,59 @@ func (p *netdataCloudProvider) Resources(ctx context.Context) []func() resource.
 func (p *netdataCloudProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewSpaceDataSource,
+		NewSpacesDataSource,
+		NewSpaceMembersDataSource,
 		NewRoomDataSource,
+		NewRoomsDataSource,
+		NewRoomMembersDataSource,
+		NewNodeDataSource,
+		NewNodesDataSource,
+		NewNotificationChannelsDataSource,
+		NewNotificationIntegrationsDataSource,
+	}
+}
+
+// int64Setting returns the configured value, falling back to the environment variable and then to the default.
+func int64Setting(value types.Int64, env string, defaultValue, minValue int64, attributePath path.Path, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() {
+		return value.ValueInt64()
+	}
+	envValue, ok := os.LookupEnv(env)
+	if !ok {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil || parsed < minValue {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Environment Variable",
+			fmt.Sprintf("%s must be an integer greater than or equal to %d, got: %q", env, minValue, envValue),
+		)
+		return defaultValue
+	}
+	return parsed
+}
+
+// float64Setting returns the configured value, falling back to the environment variable and then to the default.
+func float64Setting(value types.Float64, env string, defaultValue, minValue float64, attributePath path.Path, diags *diag.Diagnostics) float64 {
+	if !value.IsNull() {
+		return value.ValueFloat64()
+	}
+	envValue, ok := os.LookupEnv(env)
+	if !ok {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(envValue, 64)
+	// ParseFloat accepts NaN and Inf, which can't be used as a rate
+	if err != nil || math.IsNaN(parsed) || math.IsInf(parsed, 0) || parsed < minValue {
+		diags.AddAttributeError(
+			attributePath,
+			"Invalid Environment Variable",
+			fmt.Sprintf("%s must be a finite number greater than or equal to %v, got: %q", env, minValue, envValue),
+		)
+		return defaultValue
 	}
+	return parsed
 }
 
 func New(version string) func() provider.Provider {
@@ -1,7 +1,12 @@
 package provider
 
 import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
 )
 
@@ -10,3 +15,78 @@ var (
 		"netdata": providerserver.NewProtocol6WithError(New("test")()),
 	}
 )
+
+func TestInt64Setting(t *testing.T) {
+	const env = "NETDATA_CLOUD_TEST_INT64_SETTING"
+
+	tests := []struct {
+		name     string
+		value    types.Int64
+		env      *string
+		want     int64
+		wantDiag bool
+	}{
+		{name: "default", value: types.Int64Null(), want: 10},
+		{name: "environment", value: types.Int64Null(), env: ptr("3"), want: 3},
+		{name: "configuration over environment", value: types.Int64Value(5), env: ptr("3"), want: 5},
+		{name: "environment below minimum", value: types.Int64Null(), env: ptr("0"), want: 10, wantDiag: true},
+		{name: "environment not a number", value: types.Int64Null(), env: ptr("ten"), want: 10, wantDiag: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env != nil {
+				t.Setenv(env, *tt.env)
+			}
+			var diags diag.Diagnostics
+			got := int64Setting(tt.value, env, 10, 1, path.Root("test"), &diags)
+			if got != tt.want {
+				t.Errorf("expected %d, got %d", tt.want, got)
+			}
+			if diags.HasError() != tt.wantDiag {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func TestFloat64Setting(t *testing.T) {
+	const env = "NETDATA_CLOUD_TEST_FLOAT64_SETTING"
+
+	tests := []struct {
+		name     string
+		value    types.Float64
+		env      *string
+		want     float64
+		wantDiag bool
+	}{
+		{name: "default", value: types.Float64Null(), want: 10},
+		{name: "environment", value: types.Float64Null(), env: ptr("2.5"), want: 2.5},
+		{name: "environment disabling the limit", value: types.Float64Null(), env: ptr("0"), want: 0},
+		{name: "configuration over environment", value: types.Float64Value(5), env: ptr("2.5"), want: 5},
+		{name: "environment negative", value: types.Float64Null(), env: ptr("-1"), want: 10, wantDiag: true},
+		{name: "environment NaN", value: types.Float64Null(), env: ptr("NaN"), want: 10, wantDiag: true},
+		{name: "environment Inf", value: types.Float64Null(), env: ptr("Inf"), want: 10, wantDiag: true},
+		{name: "environment not a number", value: types.Float64Null(), env: ptr("fast"), want: 10, wantDiag: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.env != nil {
+				t.Setenv(env, *tt.env)
+			}
+			var diags diag.Diagnostics
+			got := float64Setting(tt.value, env, 10, 0, path.Root("test"), &diags)
+			if got != tt.want {
+				t.Errorf("expected %v, got %v", tt.want, got)
+			}
+			if diags.HasError() != tt.wantDiag {
+				t.Errorf("unexpected diagnostics: %v", diags)
+			}
+		})
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/netdata/terraform-provider-netdata/internal/client"
 )
@@ -40,15 +44,20 @@ func (s *roomDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, r
 		Description: "Use this data source to get information about a Netdata Cloud Room.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The ID of the room",
-				Required:    true,
+				Description: "The ID of the room. Exactly one of `id` or `name` must be set",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"space_id": schema.StringAttribute{
 				Description: "The ID of the space",
 				Required:    true,
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the room",
+				Description: "The name of the room. Exactly one of `id` or `name` must be set",
+				Optional:    true,
 				Computed:    true,
 			},
 			"description": schema.StringAttribute{
@@ -81,18 +90,59 @@ func (s *roomDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	var state roomDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	roomInfo, err := s.client.GetRoomByID(state.ID.ValueString(), state.SpaceID.ValueString())
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			resp.State.RemoveResource(ctx)
+	var roomInfo *client.RoomInfo
+	if !state.ID.IsNull() {
+		var err error
+		roomInfo, err = s.client.GetRoomByID(ctx, state.ID.ValueString(), state.SpaceID.ValueString())
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				resp.Diagnostics.AddError(
+					"Room Not Found",
+					"No Room Found with ID: "+state.ID.ValueString()+" in Space ID: "+state.SpaceID.ValueString(),
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Getting Room",
+				"Could Not Read Room ID: "+state.ID.ValueString()+": err: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		rooms, err := s.client.GetRooms(ctx, state.SpaceID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Getting Room",
+				"Could Not Read Room Name: "+state.Name.ValueString()+": err: "+err.Error(),
+			)
+			return
+		}
+
+		var matches []string
+		for _, room := range *rooms {
+			if room.Name == state.Name.ValueString() {
+				matches = append(matches, room.ID)
+				roomInfo = &room
+			}
+		}
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Room Not Found",
+				"No Room Found with Name: "+state.Name.ValueString()+" in Space ID: "+state.SpaceID.ValueString(),
+			)
+			return
+		}
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Rooms Found",
+				fmt.Sprintf("Found %d Rooms with Name: %s in Space ID: %s, IDs: %s. Use the id attribute instead", len(matches), state.Name.ValueString(), state.SpaceID.ValueString(), strings.Join(matches, ", ")),
+			)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error Getting Room",
-			"Could Not Read Room ID: "+state.ID.ValueString()+": err: "+err.Error(),
-		)
-		return
 	}
 
 	state.ID = types.StringValue(roomInfo.ID)
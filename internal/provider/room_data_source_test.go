@@ -2,6 +2,7 @@ package provider
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -27,6 +28,32 @@ func TestAccRoomDataSource(t *testing.T) {
 					resource.TestCheckResourceAttr("data.netdata_room.test", "name", "testAcc"),
 				),
 			},
+			{
+				Config: fmt.Sprintf(`
+					resource "netdata_room" "test" {
+						space_id    = "%s"
+						name        = "testAccByName"
+						description = "created by testAcc"
+					}
+					data "netdata_room" "test" {
+						space_id = "%s"
+						name     = netdata_room.test.name
+					}
+					`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netdata_room.test", "id", "netdata_room.test", "id"),
+					resource.TestCheckResourceAttr("data.netdata_room.test", "description", "created by testAcc"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+					data "netdata_room" "test" {
+						space_id = "%s"
+						name     = "testAccDoesNotExist"
+					}
+					`, getNonCommunitySpaceIDEnv()),
+				ExpectError: regexp.MustCompile("No Room Found with Name: testAccDoesNotExist"),
+			},
 		},
 	},
 	)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,9 +31,10 @@ type roomMemberResource struct {
 }
 
 type roomMemberResourceModel struct {
-	RoomID        types.String `tfsdk:"room_id"`
-	SpaceID       types.String `tfsdk:"space_id"`
-	SpaceMemberID types.String `tfsdk:"space_member_id"`
+	RoomID        types.String   `tfsdk:"room_id"`
+	SpaceID       types.String   `tfsdk:"space_id"`
+	SpaceMemberID types.String   `tfsdk:"space_member_id"`
+	Timeouts      timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (s *roomMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -65,6 +67,9 @@ func (s *roomMemberResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -97,7 +102,16 @@ func (s *roomMemberResource) Create(ctx context.Context, req resource.CreateRequ
 
 	tflog.Info(ctx, fmt.Sprintf("Creating room member for space_id/room_id/space_member_id: %s/%s/%s", plan.SpaceID.ValueString(), plan.RoomID.ValueString(), plan.SpaceMemberID.ValueString()))
 
-	err := s.client.CreateRoomMember(plan.SpaceID.ValueString(), plan.RoomID.ValueString(), plan.SpaceMemberID.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	err := s.client.CreateRoomMember(ctx, plan.SpaceID.ValueString(), plan.RoomID.ValueString(), plan.SpaceMemberID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Room Member",
@@ -126,7 +140,16 @@ func (s *roomMemberResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	roomMemberInfo, err := s.client.GetRoomMemberID(state.SpaceID.ValueString(), state.RoomID.ValueString(), state.SpaceMemberID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	roomMemberInfo, err := s.client.GetRoomMemberID(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString(), state.SpaceMemberID.ValueString())
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -156,7 +179,16 @@ func (s *roomMemberResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	roomMemberInfo, err := s.client.GetRoomMemberID(plan.SpaceID.ValueString(), plan.RoomID.ValueString(), plan.SpaceMemberID.ValueString())
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	roomMemberInfo, err := s.client.GetRoomMemberID(ctx, plan.SpaceID.ValueString(), plan.RoomID.ValueString(), plan.SpaceMemberID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting Room Member",
@@ -184,8 +216,20 @@ func (s *roomMemberResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	err := s.client.DeleteRoomMember(state.SpaceID.ValueString(), state.RoomID.ValueString(), state.SpaceMemberID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteRoomMember(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString(), state.SpaceMemberID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Room Member",
 			fmt.Sprintf("Could not delete room member for space_id/room_id: %s/%s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), state.SpaceMemberID.ValueString(), err.Error()),
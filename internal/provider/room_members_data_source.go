@@ -0,0 +1,133 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &roomMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &roomMembersDataSource{}
+)
+
+func NewRoomMembersDataSource() datasource.DataSource {
+	return &roomMembersDataSource{}
+}
+
+type roomMembersDataSource struct {
+	client *client.Client
+}
+
+type roomMembersDataSourceModel struct {
+	SpaceID types.String               `tfsdk:"space_id"`
+	RoomID  types.String               `tfsdk:"room_id"`
+	Members []spaceMemberDataItemModel `tfsdk:"members"`
+}
+
+func (s *roomMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_room_members"
+}
+
+func (s *roomMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the members of a Netdata Cloud Room.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Description: "The ID of the space",
+				Required:    true,
+			},
+			"room_id": schema.StringAttribute{
+				Description: "The ID of the room",
+				Required:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "The members of the room",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the space member",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email of the member",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the member",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *roomMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *roomMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state roomMembersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	roomMembers, err := s.client.GetRoomMembers(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Room Members",
+			fmt.Sprintf("Could not read room members for space_id/room_id: %s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// the room members only have the space member ID, the email and role come from the space members
+	spaceMembers, err := s.client.GetSpaceMembers(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Room Members",
+			"Could Not Read Space Members for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	spaceMembersByID := make(map[string]client.SpaceMember, len(*spaceMembers))
+	for _, spaceMember := range *spaceMembers {
+		spaceMembersByID[spaceMember.MemberID] = spaceMember
+	}
+
+	state.Members = []spaceMemberDataItemModel{}
+	for _, roomMember := range *roomMembers {
+		spaceMember := spaceMembersByID[roomMember.SpaceMemberID]
+		state.Members = append(state.Members, spaceMemberDataItemModel{
+			ID:    types.StringValue(roomMember.SpaceMemberID),
+			Email: stringValueOrNull(spaceMember.Email),
+			Role:  stringValueOrNull(spaceMember.Role),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
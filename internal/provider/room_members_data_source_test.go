@@ -0,0 +1,48 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoomMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "netdata_space_member" "test" {
+						email    = "room.members@member.local"
+						space_id = "%s"
+						role     = "admin"
+					}
+					resource "netdata_room" "test" {
+						space_id = "%s"
+						name     = "testAccRoomMembers"
+					}
+					resource "netdata_room_member" "test" {
+						room_id         = netdata_room.test.id
+						space_id        = "%s"
+						space_member_id = netdata_space_member.test.id
+					}
+					data "netdata_room_members" "test" {
+						space_id   = "%s"
+						room_id    = netdata_room.test.id
+						depends_on = [netdata_room_member.test]
+					}
+					`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.netdata_room_members.test", "members.*", map[string]string{
+						"email": "room.members@member.local",
+						"role":  "admin",
+					}),
+					resource.TestCheckTypeSetElemAttrPair("data.netdata_room_members.test", "members.*.id", "netdata_space_member.test", "id"),
+				),
+			},
+		},
+	},
+	)
+}
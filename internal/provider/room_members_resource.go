@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ resource.Resource              = &roomMembersResource{}
+	_ resource.ResourceWithConfigure = &roomMembersResource{}
+)
+
+func NewRoomMembersResource() resource.Resource {
+	return &roomMembersResource{}
+}
+
+type roomMembersResource struct {
+	client *client.Client
+}
+
+type roomMembersResourceModel struct {
+	RoomID         types.String   `tfsdk:"room_id"`
+	SpaceID        types.String   `tfsdk:"space_id"`
+	SpaceMemberIDs types.Set      `tfsdk:"space_member_ids"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (s *roomMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_room_members"
+}
+
+func (s *roomMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides an authoritative Netdata Cloud Room Members resource. Use this resource to manage the complete list of members of the room, members not in the list are removed from the room, except the user of the `auth_token`, which is never removed and is only tracked when it is in the list. It must not be used together with the `netdata_room_member` resource for the same room.",
+		Attributes: map[string]schema.Attribute{
+			"room_id": schema.StringAttribute{
+				Description: "The Room ID of the space",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_id": schema.StringAttribute{
+				Description: "Space ID of the members",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"space_member_ids": schema.SetAttribute{
+				Description: "The Space Member IDs of all the members of the room",
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (s *roomMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *roomMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan roomMembersResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var spaceMemberIDs []string
+	resp.Diagnostics.Append(plan.SpaceMemberIDs.ElementsAs(ctx, &spaceMemberIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.syncRoomMembers(ctx, plan.SpaceID.ValueString(), plan.RoomID.ValueString(), spaceMemberIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Room Members",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *roomMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state roomMembersResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	roomMembers, err := s.client.GetRoomMembers(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Getting Room Members",
+			fmt.Sprintf("Could not read room members for space_id/room_id: %s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	authenticatedID, err := s.authenticatedSpaceMemberID(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Room Members",
+			fmt.Sprintf("Could not read the authenticated space member for space_id: %s err: %v", state.SpaceID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	var stateIDs []string
+	resp.Diagnostics.Append(state.SpaceMemberIDs.ElementsAs(ctx, &stateIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceMemberIDs := []string{}
+	for _, roomMember := range *roomMembers {
+		// the user of the auth_token is never removed, so it is ignored unless it is managed
+		if roomMember.SpaceMemberID == authenticatedID && !slices.Contains(stateIDs, authenticatedID) {
+			continue
+		}
+		spaceMemberIDs = append(spaceMemberIDs, roomMember.SpaceMemberID)
+	}
+
+	state.SpaceMemberIDs, diags = types.SetValueFrom(ctx, types.StringType, spaceMemberIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *roomMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan roomMembersResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var spaceMemberIDs []string
+	resp.Diagnostics.Append(plan.SpaceMemberIDs.ElementsAs(ctx, &spaceMemberIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.syncRoomMembers(ctx, plan.SpaceID.ValueString(), plan.RoomID.ValueString(), spaceMemberIDs)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Room Members",
+			fmt.Sprintf("Could not update room members for space_id/room_id: %s/%s err: %v", plan.SpaceID.ValueString(), plan.RoomID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *roomMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state roomMembersResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	var spaceMemberIDs []string
+	resp.Diagnostics.Append(state.SpaceMemberIDs.ElementsAs(ctx, &spaceMemberIDs, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	authenticatedID, err := s.authenticatedSpaceMemberID(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Room Members",
+			fmt.Sprintf("Could not read the authenticated space member for space_id: %s err: %v", state.SpaceID.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	// removing the user of the auth_token would lock it out of the room
+	spaceMemberIDs = slices.DeleteFunc(spaceMemberIDs, func(spaceMemberID string) bool {
+		return spaceMemberID == authenticatedID
+	})
+	if len(spaceMemberIDs) == 0 {
+		return
+	}
+
+	err = s.client.DeleteRoomMembers(ctx, state.SpaceID.ValueString(), state.RoomID.ValueString(), spaceMemberIDs)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Room Members",
+			fmt.Sprintf("Could not delete room members for space_id/room_id: %s/%s err: %v", state.SpaceID.ValueString(), state.RoomID.ValueString(), err.Error()),
+		)
+		return
+	}
+}
+
+func (s *roomMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	idParts := strings.Split(req.ID, ",")
+
+	if len(idParts) != 2 || idParts[0] == "" || idParts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: space_id,room_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), idParts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("room_id"), idParts[1])...)
+}
+
+// syncRoomMembers makes the members of the room match exactly the given space member IDs,
+// adding the missing members and removing the others in bulk. The user of the auth_token is never removed.
+func (s *roomMembersResource) syncRoomMembers(ctx context.Context, spaceID, roomID string, spaceMemberIDs []string) error {
+	roomMembers, err := s.client.GetRoomMembers(ctx, spaceID, roomID)
+	if err != nil {
+		return err
+	}
+
+	authenticatedID, err := s.authenticatedSpaceMemberID(ctx, spaceID)
+	if err != nil {
+		return err
+	}
+
+	var currentIDs []string
+	for _, roomMember := range *roomMembers {
+		currentIDs = append(currentIDs, roomMember.SpaceMemberID)
+	}
+
+	var toAdd, toRemove []string
+	for _, spaceMemberID := range spaceMemberIDs {
+		if !slices.Contains(currentIDs, spaceMemberID) {
+			toAdd = append(toAdd, spaceMemberID)
+		}
+	}
+	for _, currentID := range currentIDs {
+		// removing the user of the auth_token would lock it out of the room
+		if !slices.Contains(spaceMemberIDs, currentID) && currentID != authenticatedID {
+			toRemove = append(toRemove, currentID)
+		}
+	}
+
+	if len(toAdd) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Adding room members for space_id/room_id: %s/%s: %s", spaceID, roomID, strings.Join(toAdd, ",")))
+		err = s.client.CreateRoomMembers(ctx, spaceID, roomID, toAdd)
+		if err != nil {
+			return err
+		}
+	}
+	if len(toRemove) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Removing room members for space_id/room_id: %s/%s: %s", spaceID, roomID, strings.Join(toRemove, ",")))
+		err = s.client.DeleteRoomMembers(ctx, spaceID, roomID, toRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// authenticatedSpaceMemberID returns the space member ID of the user of the auth_token,
+// or an empty string if it is not a member of the space.
+func (s *roomMembersResource) authenticatedSpaceMemberID(ctx context.Context, spaceID string) (string, error) {
+	account, err := s.client.GetCurrentAccount(ctx)
+	if err != nil {
+		return "", err
+	}
+	spaceMember, err := s.client.GetSpaceMemberByEmail(ctx, spaceID, account.Email)
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return "", nil
+		}
+		return "", err
+	}
+	return spaceMember.MemberID, nil
+}
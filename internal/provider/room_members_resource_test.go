@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoomMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_space_member" "first" {
+					email    = "first@room.members.local"
+					space_id = "%s"
+					role     = "admin"
+				}
+				resource "netdata_space_member" "second" {
+					email    = "second@room.members.local"
+					space_id = "%s"
+					role     = "admin"
+				}
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_room_members" "test" {
+					room_id          = netdata_room.test.id
+					space_id         = "%s"
+					space_member_ids = [netdata_space_member.first.id, netdata_space_member.second.id]
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_room_members.test", "room_id"),
+					resource.TestCheckResourceAttrSet("netdata_room_members.test", "space_id"),
+					resource.TestCheckResourceAttr("netdata_room_members.test", "space_member_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttrPair("netdata_room_members.test", "space_member_ids.*", "netdata_space_member.first", "id"),
+					resource.TestCheckTypeSetElemAttrPair("netdata_room_members.test", "space_member_ids.*", "netdata_space_member.second", "id"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_space_member" "first" {
+					email    = "first@room.members.local"
+					space_id = "%s"
+					role     = "admin"
+				}
+				resource "netdata_space_member" "second" {
+					email    = "second@room.members.local"
+					space_id = "%s"
+					role     = "admin"
+				}
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_room_members" "test" {
+					room_id          = netdata_room.test.id
+					space_id         = "%s"
+					space_member_ids = [netdata_space_member.second.id]
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netdata_room_members.test", "space_member_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttrPair("netdata_room_members.test", "space_member_ids.*", "netdata_space_member.second", "id"),
+				),
+			},
+		},
+	})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
@@ -30,10 +31,11 @@ type roomResource struct {
 }
 
 type roomResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	SpaceID     types.String `tfsdk:"space_id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
+	ID          types.String   `tfsdk:"id"`
+	SpaceID     types.String   `tfsdk:"space_id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (s *roomResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -69,6 +71,9 @@ func (s *roomResource) Schema(ctx context.Context, req resource.SchemaRequest, r
 				Default:     stringdefault.StaticString(""),
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -103,7 +108,16 @@ func (s *roomResource) Create(ctx context.Context, req resource.CreateRequest, r
 		return
 	}
 
-	roomInfo, err := s.client.CreateRoom(plan.SpaceID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	roomInfo, err := s.client.CreateRoom(ctx, plan.SpaceID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Room",
@@ -131,7 +145,16 @@ func (s *roomResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	roomInfo, err := s.client.GetRoomByID(state.ID.ValueString(), state.SpaceID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	roomInfo, err := s.client.GetRoomByID(ctx, state.ID.ValueString(), state.SpaceID.ValueString())
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -162,7 +185,16 @@ func (s *roomResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	err := s.client.UpdateRoomByID(plan.ID.ValueString(), plan.SpaceID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := s.client.UpdateRoomByID(ctx, plan.ID.ValueString(), plan.SpaceID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating room",
@@ -171,7 +203,7 @@ func (s *roomResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	roomInfo, err := s.client.GetRoomByID(plan.ID.ValueString(), plan.SpaceID.ValueString())
+	roomInfo, err := s.client.GetRoomByID(ctx, plan.ID.ValueString(), plan.SpaceID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting Room",
@@ -200,8 +232,20 @@ func (s *roomResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	err := s.client.DeleteRoomByID(state.ID.ValueString(), state.SpaceID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteRoomByID(ctx, state.ID.ValueString(), state.SpaceID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Room",
 			"Could Not Delete Room ID: "+state.ID.ValueString()+": err: "+err.Error(),
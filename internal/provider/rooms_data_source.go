@@ -0,0 +1,154 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &roomsDataSource{}
+	_ datasource.DataSourceWithConfigure = &roomsDataSource{}
+)
+
+func NewRoomsDataSource() datasource.DataSource {
+	return &roomsDataSource{}
+}
+
+type roomsDataSource struct {
+	client *client.Client
+}
+
+type roomsDataSourceModel struct {
+	SpaceID   types.String        `tfsdk:"space_id"`
+	NameRegex types.String        `tfsdk:"name_regex"`
+	Rooms     []roomDataItemModel `tfsdk:"rooms"`
+}
+
+type roomDataItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+	NodeCount   types.Int64  `tfsdk:"node_count"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+}
+
+func (s *roomsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_rooms"
+}
+
+func (s *roomsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the Netdata Cloud Rooms of a Space.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Description: "The ID of the space",
+				Required:    true,
+			},
+			"name_regex": schema.StringAttribute{
+				Description: "Return only the rooms with a name matching this regular expression",
+				Optional:    true,
+			},
+			"rooms": schema.ListNestedAttribute{
+				Description: "The rooms",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the room",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the room",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the room",
+							Computed:    true,
+						},
+						"node_count": schema.Int64Attribute{
+							Description: "The number of nodes in the room",
+							Computed:    true,
+						},
+						"member_count": schema.Int64Attribute{
+							Description: "The number of members in the room",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *roomsDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *roomsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state roomsDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				"Could Not Compile the Regular Expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	rooms, err := s.client.GetRooms(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Rooms",
+			"Could Not Read Rooms for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	state.Rooms = []roomDataItemModel{}
+	for _, room := range *rooms {
+		if nameRegex != nil && !nameRegex.MatchString(room.Name) {
+			continue
+		}
+		state.Rooms = append(state.Rooms, roomDataItemModel{
+			ID:          types.StringValue(room.ID),
+			Name:        types.StringValue(room.Name),
+			Description: types.StringValue(room.Description),
+			NodeCount:   types.Int64Value(room.NodeCount),
+			MemberCount: types.Int64Value(room.MemberCount),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
@@ -0,0 +1,44 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRoomsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					resource "netdata_room" "test" {
+						space_id    = "%s"
+						name        = "testAccRooms"
+						description = "created by testAcc"
+					}
+					data "netdata_rooms" "test" {
+						space_id   = "%s"
+						name_regex = "^testAccRooms$"
+						depends_on = [netdata_room.test]
+					}
+					data "netdata_rooms" "all_nodes" {
+						space_id   = "%s"
+						name_regex = "^All nodes$"
+					}
+					`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netdata_rooms.test", "rooms.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netdata_rooms.test", "rooms.0.id", "netdata_room.test", "id"),
+					resource.TestCheckResourceAttr("data.netdata_rooms.test", "rooms.0.name", "testAccRooms"),
+					resource.TestCheckResourceAttr("data.netdata_rooms.test", "rooms.0.description", "created by testAcc"),
+					resource.TestCheckResourceAttr("data.netdata_rooms.test", "rooms.0.node_count", "0"),
+					resource.TestCheckResourceAttr("data.netdata_rooms.all_nodes", "rooms.#", "1"),
+				),
+			},
+		},
+	},
+	)
+}
@@ -4,9 +4,13 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/netdata/terraform-provider-netdata/internal/client"
@@ -41,11 +45,16 @@ func (s *spaceDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 		Description: "Use this data source to get information about a Netdata Cloud Space.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The ID of the space",
-				Required:    true,
+				Description: "The ID of the space. Exactly one of `id` or `name` must be set",
+				Optional:    true,
+				Computed:    true,
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("name")),
+				},
 			},
 			"name": schema.StringAttribute{
-				Description: "The name of the space",
+				Description: "The name of the space. Exactly one of `id` or `name` must be set",
+				Optional:    true,
 				Computed:    true,
 			},
 			"description": schema.StringAttribute{
@@ -82,20 +91,63 @@ func (s *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 	var state spaceDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	tflog.Info(ctx, "Reading Space ID:"+state.ID.ValueString())
+	var spaceInfo *client.SpaceInfo
+	if !state.ID.IsNull() {
+		tflog.Info(ctx, "Reading Space ID:"+state.ID.ValueString())
 
-	spaceInfo, err := s.client.GetSpaceByID(state.ID.ValueString())
-	if err != nil {
-		if errors.Is(err, client.ErrNotFound) {
-			resp.State.RemoveResource(ctx)
+		var err error
+		spaceInfo, err = s.client.GetSpaceByID(ctx, state.ID.ValueString())
+		if err != nil {
+			if errors.Is(err, client.ErrNotFound) {
+				resp.Diagnostics.AddError(
+					"Space Not Found",
+					"No Space Found with ID: "+state.ID.ValueString(),
+				)
+				return
+			}
+			resp.Diagnostics.AddError(
+				"Error Getting Space",
+				"Could Not Read Space ID: "+state.ID.ValueString()+": err: "+err.Error(),
+			)
+			return
+		}
+	} else {
+		tflog.Info(ctx, "Reading Space Name:"+state.Name.ValueString())
+
+		spaces, err := s.client.GetSpaces(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Getting Space",
+				"Could Not Read Space Name: "+state.Name.ValueString()+": err: "+err.Error(),
+			)
+			return
+		}
+
+		var matches []string
+		for _, space := range *spaces {
+			if space.Name == state.Name.ValueString() {
+				matches = append(matches, space.ID)
+				spaceInfo = &space
+			}
+		}
+		if len(matches) == 0 {
+			resp.Diagnostics.AddError(
+				"Space Not Found",
+				"No Space Found with Name: "+state.Name.ValueString(),
+			)
+			return
+		}
+		if len(matches) > 1 {
+			resp.Diagnostics.AddError(
+				"Multiple Spaces Found",
+				fmt.Sprintf("Found %d Spaces with Name: %s, IDs: %s. Use the id attribute instead", len(matches), state.Name.ValueString(), strings.Join(matches, ", ")),
+			)
 			return
 		}
-		resp.Diagnostics.AddError(
-			"Error Getting Space",
-			"Could Not Read Space ID: "+state.ID.ValueString()+": err: "+err.Error(),
-		)
-		return
 	}
 
 	state.ID = types.StringValue(spaceInfo.ID)
@@ -107,7 +159,7 @@ func (s *spaceDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	if state.ClaimToken.IsNull() {
 		tflog.Info(ctx, "Creating Claim Token for Space ID: "+state.ID.ValueString())
-		claimToken, err := s.client.GetSpaceClaimToken(spaceInfo.ID)
+		claimToken, err := s.client.GetSpaceClaimToken(ctx, spaceInfo.ID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Creating Claim Token",
@@ -23,6 +23,29 @@ func TestAccSpaceDataSource(t *testing.T) {
 					resource.TestMatchResourceAttr("data.netdata_space.test", "claim_token", regexp.MustCompile(`^.{135}$`)),
 				),
 			},
+			{
+				Config: `
+					resource "netdata_space" "test" {
+						name = "testAccByName"
+					}
+					data "netdata_space" "test" {
+						name = netdata_space.test.name
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("data.netdata_space.test", "id", "netdata_space.test", "id"),
+					resource.TestMatchResourceAttr("data.netdata_space.test", "claim_token", regexp.MustCompile(`^.{135}$`)),
+				),
+			},
+			{
+				Config: `
+					data "netdata_space" "test" {
+						id   = "00000000-0000-0000-0000-000000000000"
+						name = "testAcc"
+					}
+					`,
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
 		},
 	},
 	)
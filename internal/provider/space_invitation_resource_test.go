@@ -0,0 +1,39 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpaceInvitationResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_room" "test" {
+					space_id = "%s"
+					name     = "testAcc"
+				}
+				resource "netdata_space_invitation" "test" {
+					email    = "space@invitation.local"
+					space_id = "%s"
+					role     = "admin"
+					rooms_id = [netdata_room.test.id]
+				}
+				`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_space_invitation.test", "id"),
+					resource.TestCheckResourceAttr("netdata_space_invitation.test", "email", "space@invitation.local"),
+					resource.TestCheckResourceAttr("netdata_space_invitation.test", "role", "admin"),
+					resource.TestCheckResourceAttrSet("netdata_space_invitation.test", "space_id"),
+					resource.TestCheckResourceAttr("netdata_space_invitation.test", "rooms_id.#", "1"),
+					resource.TestCheckResourceAttrSet("netdata_space_invitation.test", "status"),
+				),
+			},
+		},
+	})
+}
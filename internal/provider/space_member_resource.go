@@ -7,10 +7,12 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
@@ -20,8 +22,9 @@ import (
 )
 
 var (
-	_ resource.Resource              = &spaceMemberResource{}
-	_ resource.ResourceWithConfigure = &spaceMemberResource{}
+	_ resource.Resource               = &spaceMemberResource{}
+	_ resource.ResourceWithConfigure  = &spaceMemberResource{}
+	_ resource.ResourceWithModifyPlan = &spaceMemberResource{}
 )
 
 func NewSpaceMemberResource() resource.Resource {
@@ -33,10 +36,12 @@ type spaceMemberResource struct {
 }
 
 type spaceMemberResourceModel struct {
-	ID      types.String `tfsdk:"id"`
-	Email   types.String `tfsdk:"email"`
-	Role    types.String `tfsdk:"role"`
-	SpaceID types.String `tfsdk:"space_id"`
+	ID       types.String   `tfsdk:"id"`
+	Email    types.String   `tfsdk:"email"`
+	Role     types.String   `tfsdk:"role"`
+	SpaceID  types.String   `tfsdk:"space_id"`
+	Pending  types.Bool     `tfsdk:"pending"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (s *spaceMemberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -45,10 +50,10 @@ func (s *spaceMemberResource) Metadata(ctx context.Context, req resource.Metadat
 
 func (s *spaceMemberResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description: "Provides a Netdata Cloud Space Member resource. Use this resource to manage user membership to the space.",
+		Description: "Provides a Netdata Cloud Space Member resource. Use this resource to manage user membership to the space. The user is invited to the space and stays `pending` until the invitation is accepted.",
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				Description: "The Member ID of the space",
+				Description: "The Member ID of the space. It is empty while the invitation is pending",
 				Computed:    true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
@@ -84,6 +89,16 @@ func (s *spaceMemberResource) Schema(ctx context.Context, req resource.SchemaReq
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"pending": schema.BoolAttribute{
+				Description: "Whether the invitation of the member is still pending",
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
 		},
 	}
 }
@@ -117,7 +132,16 @@ func (s *spaceMemberResource) Create(ctx context.Context, req resource.CreateReq
 
 	tflog.Info(ctx, "Creating space member for email: "+plan.Email.ValueString())
 
-	spaceMemberInfo, err := s.client.CreateSpaceMember(plan.SpaceID.ValueString(), plan.Email.ValueString(), plan.Role.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	_, err := s.client.CreateSpaceMember(ctx, plan.SpaceID.ValueString(), plan.Email.ValueString(), plan.Role.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Space Member",
@@ -126,10 +150,14 @@ func (s *spaceMemberResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
-	plan.ID = types.StringValue(spaceMemberInfo.MemberID)
-	plan.Email = types.StringValue(plan.Email.ValueString())
-	plan.Role = types.StringValue(spaceMemberInfo.Role)
-	plan.SpaceID = types.StringValue(plan.SpaceID.ValueString())
+	err = s.readSpaceMemberByEmail(ctx, &plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Space Member",
+			fmt.Sprintf("Could not read space member for space_id/email: %s/%s err: %v", plan.SpaceID.ValueString(), plan.Email.ValueString(), err.Error()),
+		)
+		return
+	}
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -149,7 +177,29 @@ func (s *spaceMemberResource) Read(ctx context.Context, req resource.ReadRequest
 
 	tflog.Info(ctx, "Reading space member for space_id/id: "+state.Email.ValueString()+"/"+state.ID.ValueString())
 
-	spaceMemberInfo, err := s.client.GetSpaceMemberID(state.SpaceID.ValueString(), state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var err error
+	if state.ID.ValueString() != "" {
+		var spaceMemberInfo *client.SpaceMember
+		spaceMemberInfo, err = s.client.GetSpaceMemberID(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
+		if err == nil {
+			state.ID = types.StringValue(spaceMemberInfo.MemberID)
+			state.Email = types.StringValue(spaceMemberInfo.Email)
+			state.Role = types.StringValue(spaceMemberInfo.Role)
+			state.Pending = types.BoolValue(false)
+		}
+	}
+	if state.ID.ValueString() == "" || errors.Is(err, client.ErrNotFound) {
+		err = s.readSpaceMemberByEmail(ctx, &state)
+	}
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -157,14 +207,11 @@ func (s *spaceMemberResource) Read(ctx context.Context, req resource.ReadRequest
 		}
 		resp.Diagnostics.AddError(
 			"Error Getting Space Member",
-			fmt.Sprintf("Could not read space member for space_id/space_member_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
+			fmt.Sprintf("Could not read space member for space_id/email: %s/%s err: %v", state.SpaceID.ValueString(), state.Email.ValueString(), err.Error()),
 		)
 		return
 	}
 
-	state.ID = types.StringValue(spaceMemberInfo.MemberID)
-	state.Email = types.StringValue(spaceMemberInfo.Email)
-	state.Role = types.StringValue(spaceMemberInfo.Role)
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -172,6 +219,25 @@ func (s *spaceMemberResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 }
 
+func (s *spaceMemberResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var state, plan spaceMemberResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// changing the role of a pending member sends the invitation again, so the outcome is only known after apply
+	if state.Pending.ValueBool() && !plan.Role.Equal(state.Role) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("pending"), types.BoolUnknown())...)
+	}
+}
+
 func (s *spaceMemberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	var plan spaceMemberResourceModel
 
@@ -181,7 +247,58 @@ func (s *spaceMemberResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	err := s.client.UpdateSpaceMemberRoleByID(plan.SpaceID.ValueString(), plan.ID.ValueString(), plan.Role.ValueString())
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	if plan.ID.ValueString() == "" {
+		// a pending invitation can't be updated, so it is revoked and sent again with the new role
+		invitation, err := s.client.GetInvitationByEmail(ctx, plan.SpaceID.ValueString(), plan.Email.ValueString())
+		if err != nil && !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"Error Updating Space Member Role",
+				fmt.Sprintf("Could not read space invitation for space_id/email: %s/%s err: %v", plan.SpaceID.ValueString(), plan.Email.ValueString(), err.Error()),
+			)
+			return
+		}
+		if err == nil {
+			err = s.client.DeleteInvitations(ctx, plan.SpaceID.ValueString(), &[]client.Invitation{*invitation})
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error Updating Space Member Role",
+					fmt.Sprintf("Could not revoke space invitation for space_id/email: %s/%s err: %v", plan.SpaceID.ValueString(), plan.Email.ValueString(), err.Error()),
+				)
+				return
+			}
+		}
+		_, err = s.client.CreateSpaceMember(ctx, plan.SpaceID.ValueString(), plan.Email.ValueString(), plan.Role.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Updating Space Member Role",
+				fmt.Sprintf("Could not invite space member for space_id/email: %s/%s err: %v", plan.SpaceID.ValueString(), plan.Email.ValueString(), err.Error()),
+			)
+			return
+		}
+		err = s.readSpaceMemberByEmail(ctx, &plan)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Getting Space Member",
+				fmt.Sprintf("Could not read space member for space_id/email: %s/%s err: %v", plan.SpaceID.ValueString(), plan.Email.ValueString(), err.Error()),
+			)
+			return
+		}
+
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
+	err := s.client.UpdateSpaceMemberRoleByID(ctx, plan.SpaceID.ValueString(), plan.ID.ValueString(), plan.Role.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Space Member Role",
@@ -190,7 +307,7 @@ func (s *spaceMemberResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
-	spaceMemberInfo, err := s.client.GetSpaceMemberID(plan.SpaceID.ValueString(), plan.ID.ValueString())
+	spaceMemberInfo, err := s.client.GetSpaceMemberID(ctx, plan.SpaceID.ValueString(), plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting Space Member",
@@ -202,6 +319,7 @@ func (s *spaceMemberResource) Update(ctx context.Context, req resource.UpdateReq
 	plan.ID = types.StringValue(spaceMemberInfo.MemberID)
 	plan.Email = types.StringValue(spaceMemberInfo.Email)
 	plan.Role = types.StringValue(spaceMemberInfo.Role)
+	plan.Pending = types.BoolValue(false)
 
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -220,8 +338,34 @@ func (s *spaceMemberResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
-	err := s.client.DeleteSpaceMember(state.SpaceID.ValueString(), state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	if state.ID.ValueString() == "" {
+		invitation, err := s.client.GetInvitationByEmail(ctx, state.SpaceID.ValueString(), state.Email.ValueString())
+		if err == nil {
+			err = s.client.DeleteInvitations(ctx, state.SpaceID.ValueString(), &[]client.Invitation{*invitation})
+		}
+		if err != nil && !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Space Member",
+				fmt.Sprintf("Could not revoke space invitation for space_id/email: %s/%s err: %v", state.SpaceID.ValueString(), state.Email.ValueString(), err.Error()),
+			)
+		}
+		return
+	}
+
+	err := s.client.DeleteSpaceMember(ctx, state.SpaceID.ValueString(), state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Space Member",
 			fmt.Sprintf("Could not delete space member for space_id/space_member_id: %s/%s err: %v", state.SpaceID.ValueString(), state.ID.ValueString(), err.Error()),
@@ -244,3 +388,29 @@ func (s *spaceMemberResource) ImportState(ctx context.Context, req resource.Impo
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("space_id"), idParts[0])...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), idParts[1])...)
 }
+
+// readSpaceMemberByEmail sets the ID, role and pending status of the member from the space members or,
+// until the invitation is accepted, from the pending invitation, in which case the ID is left empty.
+func (s *spaceMemberResource) readSpaceMemberByEmail(ctx context.Context, model *spaceMemberResourceModel) error {
+	spaceMemberInfo, err := s.client.GetSpaceMemberByEmail(ctx, model.SpaceID.ValueString(), model.Email.ValueString())
+	if err == nil {
+		model.ID = types.StringValue(spaceMemberInfo.MemberID)
+		model.Role = types.StringValue(spaceMemberInfo.Role)
+		model.Pending = types.BoolValue(false)
+		return nil
+	}
+	if !errors.Is(err, client.ErrNotFound) {
+		return err
+	}
+
+	invitation, err := s.client.GetInvitationByEmail(ctx, model.SpaceID.ValueString(), model.Email.ValueString())
+	if err != nil {
+		return err
+	}
+	model.ID = types.StringValue("")
+	if invitation.Role != "" {
+		model.Role = types.StringValue(invitation.Role)
+	}
+	model.Pending = types.BoolValue(true)
+	return nil
+}
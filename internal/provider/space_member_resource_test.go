@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 func TestAccSpaceMemberResource(t *testing.T) {
@@ -20,12 +21,40 @@ func TestAccSpaceMemberResource(t *testing.T) {
 				}
 				`, getNonCommunitySpaceIDEnv()),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttrSet("netdata_space_member.test", "id"),
 					resource.TestCheckResourceAttr("netdata_space_member.test", "email", "space@member.local"),
 					resource.TestCheckResourceAttr("netdata_space_member.test", "role", "admin"),
 					resource.TestCheckResourceAttrSet("netdata_space_member.test", "space_id"),
+					testAccCheckSpaceMemberPending("netdata_space_member.test"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+				resource "netdata_space_member" "test" {
+					email    = "space@member.local"
+					space_id = "%s"
+					role     = "member"
+				}
+				`, getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("netdata_space_member.test", "role", "member"),
+					testAccCheckSpaceMemberPending("netdata_space_member.test"),
 				),
 			},
 		},
 	})
 }
+
+// testAccCheckSpaceMemberPending checks that the member ID is set only once the invitation is accepted.
+func testAccCheckSpaceMemberPending(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource not found: %s", resourceName)
+		}
+		id, pending := rs.Primary.Attributes["id"], rs.Primary.Attributes["pending"]
+		if (pending == "true") != (id == "") {
+			return fmt.Errorf("expected an empty id only while pending, got id %q and pending %q", id, pending)
+		}
+		return nil
+	}
+}
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &spaceMembersDataSource{}
+	_ datasource.DataSourceWithConfigure = &spaceMembersDataSource{}
+)
+
+func NewSpaceMembersDataSource() datasource.DataSource {
+	return &spaceMembersDataSource{}
+}
+
+type spaceMembersDataSource struct {
+	client *client.Client
+}
+
+type spaceMembersDataSourceModel struct {
+	SpaceID types.String               `tfsdk:"space_id"`
+	Role    types.String               `tfsdk:"role"`
+	Email   types.String               `tfsdk:"email"`
+	Members []spaceMemberDataItemModel `tfsdk:"members"`
+}
+
+type spaceMemberDataItemModel struct {
+	ID    types.String `tfsdk:"id"`
+	Email types.String `tfsdk:"email"`
+	Role  types.String `tfsdk:"role"`
+}
+
+func (s *spaceMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_members"
+}
+
+func (s *spaceMembersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the members of a Netdata Cloud Space.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Description: "The ID of the space",
+				Required:    true,
+			},
+			"role": schema.StringAttribute{
+				Description: "Return only the members with this role, e.g. `admin`",
+				Optional:    true,
+			},
+			"email": schema.StringAttribute{
+				Description: "Return only the member with this email, compared case-insensitively",
+				Optional:    true,
+			},
+			"members": schema.ListNestedAttribute{
+				Description: "The members of the space",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the space member, as used by `netdata_room_member`",
+							Computed:    true,
+						},
+						"email": schema.StringAttribute{
+							Description: "The email of the member",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "The role of the member",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *spaceMembersDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *spaceMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state spaceMembersDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceMembers, err := s.client.GetSpaceMembers(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Space Members",
+			"Could Not Read Space Members for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	state.Members = []spaceMemberDataItemModel{}
+	for _, spaceMember := range *spaceMembers {
+		if !state.Role.IsNull() && spaceMember.Role != state.Role.ValueString() {
+			continue
+		}
+		if !state.Email.IsNull() && !strings.EqualFold(spaceMember.Email, state.Email.ValueString()) {
+			continue
+		}
+		state.Members = append(state.Members, spaceMemberDataItemModel{
+			ID:    types.StringValue(spaceMember.MemberID),
+			Email: types.StringValue(spaceMember.Email),
+			Role:  types.StringValue(spaceMember.Role),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
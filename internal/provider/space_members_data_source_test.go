@@ -0,0 +1,38 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpaceMembersDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+					data "netdata_space_members" "admins" {
+						space_id = "%s"
+						role     = "admin"
+					}
+					data "netdata_space_members" "none" {
+						space_id = "%s"
+						email    = "testacc@does-not-exist.local"
+					}
+					`, getNonCommunitySpaceIDEnv(), getNonCommunitySpaceIDEnv()),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("data.netdata_space_members.admins", "members.#", regexp.MustCompile(`^[1-9][0-9]*$`)),
+					resource.TestCheckResourceAttr("data.netdata_space_members.admins", "members.0.role", "admin"),
+					resource.TestCheckResourceAttrSet("data.netdata_space_members.admins", "members.0.id"),
+					resource.TestCheckResourceAttrSet("data.netdata_space_members.admins", "members.0.email"),
+					resource.TestCheckResourceAttr("data.netdata_space_members.none", "members.#", "0"),
+				),
+			},
+		},
+	},
+	)
+}
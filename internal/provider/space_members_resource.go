@@ -0,0 +1,463 @@
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ resource.Resource              = &spaceMembersResource{}
+	_ resource.ResourceWithConfigure = &spaceMembersResource{}
+)
+
+func NewSpaceMembersResource() resource.Resource {
+	return &spaceMembersResource{}
+}
+
+type spaceMembersResource struct {
+	client *client.Client
+}
+
+type spaceMembersResourceModel struct {
+	SpaceID  types.String   `tfsdk:"space_id"`
+	Members  types.Map      `tfsdk:"members"`
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (s *spaceMembersResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_space_members"
+}
+
+func (s *spaceMembersResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides an authoritative Netdata Cloud Space Members resource. Use this resource to manage the complete list of members of the space, including the pending invitations. Members and invitations not in the list are removed from the space, except the user of the `auth_token`, which is never removed and is only tracked when it is in the list. It must not be used together with the `netdata_space_member` resource for the same space.",
+		Attributes: map[string]schema.Attribute{
+			"space_id": schema.StringAttribute{
+				Description: "Space ID of the members",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"members": schema.MapAttribute{
+				Description: "Map of the email to the role of every member of the space. The community plan can only set the role to `admin`",
+				ElementType: types.StringType,
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}$`),
+							"Invalid email format",
+						),
+					),
+					mapvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(
+							regexp.MustCompile(`^[a-z0-9]+$`),
+							"Role should be lowercase",
+						),
+					),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
+	}
+}
+
+func (s *spaceMembersResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *spaceMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan spaceMembersResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	var members map[string]string
+	resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.syncSpaceMembers(ctx, plan.SpaceID.ValueString(), members)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Space Members",
+			"err: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *spaceMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state spaceMembersResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	var stateMembers map[string]string
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &stateMembers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceMembers, err := s.client.GetSpaceMembers(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Getting Space Members",
+			"Could Not Read Space Members for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	invitations, err := s.client.GetInvitations(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Space Members",
+			"Could Not Read Invitations for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	account, err := s.client.GetCurrentAccount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Space Members",
+			"Could Not Read the Authenticated Account: err: "+err.Error(),
+		)
+		return
+	}
+
+	// the emails are compared case-insensitively, keep the spelling used in the configuration
+	stateEmails := make(map[string]string, len(stateMembers))
+	for email := range stateMembers {
+		stateEmails[strings.ToLower(email)] = email
+	}
+	emailKey := func(email string) string {
+		if stateEmail, ok := stateEmails[strings.ToLower(email)]; ok {
+			return stateEmail
+		}
+		return email
+	}
+
+	members := map[string]string{}
+	for _, invitation := range *invitations {
+		role := invitation.Role
+		if role == "" {
+			role = stateMembers[emailKey(invitation.Email)]
+		}
+		members[emailKey(invitation.Email)] = role
+	}
+	for _, spaceMember := range *spaceMembers {
+		// the user of the auth_token is never removed, so it is ignored unless it is managed
+		if _, ok := stateMembers[emailKey(spaceMember.Email)]; !ok && strings.EqualFold(spaceMember.Email, account.Email) {
+			continue
+		}
+		members[emailKey(spaceMember.Email)] = spaceMember.Role
+	}
+
+	state.Members, diags = types.MapValueFrom(ctx, types.StringType, members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *spaceMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan spaceMembersResourceModel
+
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	var members map[string]string
+	resp.Diagnostics.Append(plan.Members.ElementsAs(ctx, &members, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := s.syncSpaceMembers(ctx, plan.SpaceID.ValueString(), members)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Space Members",
+			"Could Not Update Space Members for Space ID: "+plan.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (s *spaceMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state spaceMembersResourceModel
+
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	var stateMembers map[string]string
+	resp.Diagnostics.Append(state.Members.ElementsAs(ctx, &stateMembers, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	spaceMembers, err := s.client.GetSpaceMembers(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Space Members",
+			"Could Not Read Space Members for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	invitations, err := s.client.GetInvitations(ctx, state.SpaceID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Space Members",
+			"Could Not Read Invitations for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+
+	account, err := s.client.GetCurrentAccount(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Deleting Space Members",
+			"Could Not Read the Authenticated Account: err: "+err.Error(),
+		)
+		return
+	}
+
+	managed := make(map[string]bool, len(stateMembers))
+	for email := range stateMembers {
+		managed[strings.ToLower(email)] = true
+	}
+	// removing the user of the auth_token would lock it out of the space
+	delete(managed, strings.ToLower(account.Email))
+
+	var memberIDs []string
+	for _, spaceMember := range *spaceMembers {
+		if managed[strings.ToLower(spaceMember.Email)] {
+			memberIDs = append(memberIDs, spaceMember.MemberID)
+		}
+	}
+	var revokedInvitations []client.Invitation
+	for _, invitation := range *invitations {
+		if managed[strings.ToLower(invitation.Email)] {
+			revokedInvitations = append(revokedInvitations, invitation)
+		}
+	}
+
+	if len(memberIDs) > 0 {
+		err = s.client.DeleteSpaceMembers(ctx, state.SpaceID.ValueString(), memberIDs)
+		if err != nil && !errors.Is(err, client.ErrNotFound) {
+			resp.Diagnostics.AddError(
+				"Error Deleting Space Members",
+				"Could Not Delete Space Members for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	err = s.client.DeleteInvitations(ctx, state.SpaceID.ValueString(), &revokedInvitations)
+	if err != nil && !errors.Is(err, client.ErrNotFound) {
+		resp.Diagnostics.AddError(
+			"Error Deleting Space Members",
+			"Could Not Delete Invitations for Space ID: "+state.SpaceID.ValueString()+": err: "+err.Error(),
+		)
+		return
+	}
+}
+
+func (s *spaceMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("space_id"), req, resp)
+}
+
+// syncSpaceMembers makes the members and the pending invitations of the space match exactly the given
+// email to role map: missing members are invited, roles are updated, the others are removed in bulk.
+// The user of the auth_token is never removed.
+func (s *spaceMembersResource) syncSpaceMembers(ctx context.Context, spaceID string, members map[string]string) error {
+	spaceMembers, err := s.client.GetSpaceMembers(ctx, spaceID)
+	if err != nil {
+		return err
+	}
+
+	invitations, err := s.client.GetInvitations(ctx, spaceID)
+	if err != nil {
+		return err
+	}
+
+	account, err := s.client.GetCurrentAccount(ctx)
+	if err != nil {
+		return err
+	}
+
+	desired := make(map[string]string, len(members))
+	for email, role := range members {
+		desired[strings.ToLower(email)] = role
+	}
+
+	existing := map[string]bool{}
+	var memberIDsToRemove []string
+	for _, spaceMember := range *spaceMembers {
+		email := strings.ToLower(spaceMember.Email)
+		role, ok := desired[email]
+		if !ok {
+			// removing the user of the auth_token would lock it out of the space
+			if email != strings.ToLower(account.Email) {
+				memberIDsToRemove = append(memberIDsToRemove, spaceMember.MemberID)
+			}
+			continue
+		}
+		existing[email] = true
+		if role != spaceMember.Role {
+			tflog.Info(ctx, fmt.Sprintf("Updating role of space member %s to %s for space_id: %s", spaceMember.Email, role, spaceID))
+			err = s.client.UpdateSpaceMemberRoleByID(ctx, spaceID, spaceMember.MemberID, role)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	// an invitation can't be updated, so an invitation with another role is revoked and sent again
+	var invitationsToRevoke []client.Invitation
+	for _, invitation := range *invitations {
+		email := strings.ToLower(invitation.Email)
+		role, ok := desired[email]
+		if !ok || existing[email] || (invitation.Role != "" && invitation.Role != role) {
+			invitationsToRevoke = append(invitationsToRevoke, invitation)
+			continue
+		}
+		existing[email] = true
+	}
+
+	if len(memberIDsToRemove) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Removing space members for space_id: %s: %s", spaceID, strings.Join(memberIDsToRemove, ",")))
+		err = s.client.DeleteSpaceMembers(ctx, spaceID, memberIDsToRemove)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(invitationsToRevoke) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("Revoking %d invitations for space_id: %s", len(invitationsToRevoke), spaceID))
+		err = s.client.DeleteInvitations(ctx, spaceID, &invitationsToRevoke)
+		if err != nil {
+			return err
+		}
+	}
+
+	for email, role := range members {
+		if existing[strings.ToLower(email)] {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("Inviting space member %s with role %s for space_id: %s", email, role, spaceID))
+		_, err = s.client.CreateSpaceMember(ctx, spaceID, email, role)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,53 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpaceMembersResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+				resource "netdata_space" "test" {
+					name = "testAcc"
+				}
+				resource "netdata_space_members" "test" {
+					space_id = netdata_space.test.id
+					members = {
+						"first@space.members.local"  = "admin"
+						"second@space.members.local" = "admin"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("netdata_space_members.test", "space_id"),
+					resource.TestCheckResourceAttr("netdata_space_members.test", "members.first@space.members.local", "admin"),
+					resource.TestCheckResourceAttr("netdata_space_members.test", "members.second@space.members.local", "admin"),
+				),
+			},
+			{
+				Config: `
+				resource "netdata_space" "test" {
+					name = "testAcc"
+				}
+				resource "netdata_space_members" "test" {
+					space_id = netdata_space.test.id
+					members = {
+						"second@space.members.local" = "admin"
+					}
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("netdata_space_members.test", "members.first@space.members.local"),
+					resource.TestCheckResourceAttr("netdata_space_members.test", "members.second@space.members.local", "admin"),
+					resource.TestCheckResourceAttr("netdata_space_members.test", "members.%", "1"),
+				),
+			},
+		},
+	})
+}
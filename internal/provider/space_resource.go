@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -32,10 +33,11 @@ type spaceResource struct {
 }
 
 type spaceResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	Name        types.String `tfsdk:"name"`
-	Description types.String `tfsdk:"description"`
-	ClaimToken  types.String `tfsdk:"claim_token"`
+	ID          types.String   `tfsdk:"id"`
+	Name        types.String   `tfsdk:"name"`
+	Description types.String   `tfsdk:"description"`
+	ClaimToken  types.String   `tfsdk:"claim_token"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (s *spaceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -74,6 +76,9 @@ func (s *spaceResource) Schema(ctx context.Context, req resource.SchemaRequest,
 				},
 			},
 		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.BlockAll(ctx),
+		},
 	}
 }
 
@@ -110,7 +115,16 @@ func (s *spaceResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Info(ctx, "Creating space: "+plan.Name.ValueString())
 
-	spaceInfo, err := s.client.CreateSpace(plan.Name.ValueString(), plan.Description.ValueString())
+	createTimeout, diags := plan.Timeouts.Create(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	spaceInfo, err := s.client.CreateSpace(ctx, plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Space",
@@ -125,7 +139,7 @@ func (s *spaceResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	tflog.Info(ctx, "Creating Claim Token for Space ID: "+spaceInfo.ID)
 
-	claimToken, err := s.client.GetSpaceClaimToken(spaceInfo.ID)
+	claimToken, err := s.client.GetSpaceClaimToken(ctx, spaceInfo.ID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Claim Token",
@@ -151,7 +165,16 @@ func (s *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	spaceInfo, err := s.client.GetSpaceByID(state.ID.ValueString())
+	readTimeout, diags := state.Timeouts.Read(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, readTimeout)
+	defer cancel()
+
+	spaceInfo, err := s.client.GetSpaceByID(ctx, state.ID.ValueString())
 	if err != nil {
 		if errors.Is(err, client.ErrNotFound) {
 			resp.State.RemoveResource(ctx)
@@ -166,7 +189,7 @@ func (s *spaceResource) Read(ctx context.Context, req resource.ReadRequest, resp
 
 	if state.ClaimToken.IsNull() {
 		tflog.Info(ctx, "Creating Claim Token for Space ID: "+spaceInfo.ID)
-		claimToken, err := s.client.GetSpaceClaimToken(spaceInfo.ID)
+		claimToken, err := s.client.GetSpaceClaimToken(ctx, spaceInfo.ID)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Creating Claim Token",
@@ -194,7 +217,16 @@ func (s *spaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	err := s.client.UpdateSpaceByID(plan.ID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
+	updateTimeout, diags := plan.Timeouts.Update(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	err := s.client.UpdateSpaceByID(ctx, plan.ID.ValueString(), plan.Name.ValueString(), plan.Description.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Space",
@@ -203,7 +235,7 @@ func (s *spaceResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	spaceInfo, err := s.client.GetSpaceByID(plan.ID.ValueString())
+	spaceInfo, err := s.client.GetSpaceByID(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Getting Space",
@@ -232,8 +264,20 @@ func (s *spaceResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	err := s.client.DeleteSpaceByID(state.ID.ValueString())
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, defaultOperationTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	err := s.client.DeleteSpaceByID(ctx, state.ID.ValueString())
 	if err != nil {
+		if errors.Is(err, client.ErrNotFound) {
+			return
+		}
 		resp.Diagnostics.AddError(
 			"Error Deleting Space",
 			"Could Not Delete Space ID: "+state.ID.ValueString()+": err: "+err.Error(),
@@ -0,0 +1,137 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/netdata/terraform-provider-netdata/internal/client"
+)
+
+var (
+	_ datasource.DataSource              = &spacesDataSource{}
+	_ datasource.DataSourceWithConfigure = &spacesDataSource{}
+)
+
+func NewSpacesDataSource() datasource.DataSource {
+	return &spacesDataSource{}
+}
+
+type spacesDataSource struct {
+	client *client.Client
+}
+
+type spacesDataSourceModel struct {
+	NameRegex types.String         `tfsdk:"name_regex"`
+	Spaces    []spaceDataItemModel `tfsdk:"spaces"`
+}
+
+type spaceDataItemModel struct {
+	ID          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	Description types.String `tfsdk:"description"`
+}
+
+func (s *spacesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_spaces"
+}
+
+func (s *spacesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Use this data source to list the Netdata Cloud Spaces visible to the token.",
+		Attributes: map[string]schema.Attribute{
+			"name_regex": schema.StringAttribute{
+				Description: "Return only the spaces with a name matching this regular expression",
+				Optional:    true,
+			},
+			"spaces": schema.ListNestedAttribute{
+				Description: "The spaces",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Description: "The ID of the space",
+							Computed:    true,
+						},
+						"name": schema.StringAttribute{
+							Description: "The name of the space",
+							Computed:    true,
+						},
+						"description": schema.StringAttribute{
+							Description: "The description of the space",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *spacesDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	s.client = client
+}
+
+func (s *spacesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state spacesDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !state.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(state.NameRegex.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("name_regex"),
+				"Invalid Name Regex",
+				"Could Not Compile the Regular Expression: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	spaces, err := s.client.GetSpaces(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Getting Spaces",
+			"Could Not Read Spaces: err: "+err.Error(),
+		)
+		return
+	}
+
+	state.Spaces = []spaceDataItemModel{}
+	for _, space := range *spaces {
+		if nameRegex != nil && !nameRegex.MatchString(space.Name) {
+			continue
+		}
+		state.Spaces = append(state.Spaces, spaceDataItemModel{
+			ID:          types.StringValue(space.ID),
+			Name:        types.StringValue(space.Name),
+			Description: types.StringValue(space.Description),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
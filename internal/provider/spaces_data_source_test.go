@@ -0,0 +1,43 @@
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSpacesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+					resource "netdata_space" "test" {
+						name        = "testAccSpaces"
+						description = "created by testAcc"
+					}
+					data "netdata_spaces" "test" {
+						name_regex = "^testAccSpaces$"
+						depends_on = [netdata_space.test]
+					}
+					`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.netdata_spaces.test", "spaces.#", "1"),
+					resource.TestCheckResourceAttrPair("data.netdata_spaces.test", "spaces.0.id", "netdata_space.test", "id"),
+					resource.TestCheckResourceAttr("data.netdata_spaces.test", "spaces.0.name", "testAccSpaces"),
+					resource.TestCheckResourceAttr("data.netdata_spaces.test", "spaces.0.description", "created by testAcc"),
+				),
+			},
+			{
+				Config: `data "netdata_spaces" "test" {}`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.netdata_spaces.test", "spaces.*", map[string]string{
+						"id": getNonCommunitySpaceIDEnv(),
+					}),
+				),
+			},
+		},
+	},
+	)
+}
@@ -1,6 +1,7 @@
 package provider
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"testing"
@@ -34,12 +35,12 @@ func init() {
 			}
 
 			client := client.NewClient(url, auth_token)
-			invitations, err := client.GetInvitations(spaceID)
+			invitations, err := client.GetInvitations(context.Background(), spaceID)
 			if err != nil {
 				return err
 			}
 
-			err = client.DeleteInvitations(spaceID, invitations)
+			err = client.DeleteInvitations(context.Background(), spaceID, invitations)
 			if err != nil {
 				return err
 			}
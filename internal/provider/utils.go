@@ -1,8 +1,11 @@
 package provider
 
 import (
+	"fmt"
 	"os"
 	"testing"
+
+	"github.com/netdata/terraform-provider-netdata/internal/client"
 )
 
 const (
@@ -26,3 +29,54 @@ func getNetdataCloudURL() string {
 	}
 	return url
 }
+
+// testAccInstallAgentConfig returns a terraform_data resource running a Netdata Agent named netdata-agent
+// in Docker, claimed to the space of the given netdata_space resource.
+func testAccInstallAgentConfig(spaceResource string) string {
+	return fmt.Sprintf(`
+				resource "terraform_data" "install_agent" {
+					provisioner "local-exec" {
+					  command = <<EOT
+cat > docker-compose.yml <<EOF
+services:
+  netdata:
+    image: netdata/netdata:stable
+    container_name: netdata-agent
+    restart: unless-stopped
+    hostname: "netdata-agent"
+    cap_add:
+      - SYS_PTRACE
+      - SYS_ADMIN
+    security_opt:
+      - apparmor:unconfined
+    volumes:
+      - /etc/passwd:/host/etc/passwd:ro
+      - /etc/group:/host/etc/group:ro
+      - /etc/localtime:/etc/localtime:ro
+      - /proc:/host/proc:ro
+      - /sys:/host/sys:ro
+      - /etc/os-release:/host/etc/os-release:ro
+      - /var/log:/host/var/log:ro
+      - /var/run/docker.sock:/var/run/docker.sock:ro
+    environment:
+      - NETDATA_CLAIM_TOKEN=$${NETDATA_CLAIM_TOKEN}
+      - NETDATA_CLAIM_URL=%s
+EOF
+docker compose up -d && sleep 30
+EOT
+					  environment = {
+					    NETDATA_CLAIM_TOKEN = %s.claim_token
+					  }
+					}
+					provisioner "local-exec" {
+					  when    = destroy
+					  command = "docker compose down"
+					}
+				}
+				`, getNetdataCloudURL(), spaceResource)
+}
+
+// testAccClient returns a client configured from the same environment variables as the provider.
+func testAccClient() *client.Client {
+	return client.NewClient(getNetdataCloudURL(), os.Getenv("NETDATA_CLOUD_AUTH_TOKEN"))
+}